@@ -0,0 +1,69 @@
+// Command hackerrank solves a HackerRank problem exactly as HackerRank runs
+// it: the input is read from standard input, and the output is written to
+// standard output. The problem is named by its URL, or by the slug in its URL, e.g.
+//
+//	hackerrank balanced-forest < interviews/trees/balanced-forest-inputs/input00.txt
+//
+// Run "hackerrank -list" to see every problem that can be solved.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/abucarlo/hackerrank/interviews/problems"
+)
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("hackerrank", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	list := flags.Bool("list", false, "list the problems that can be solved")
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: hackerrank [-list] problem < input")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	if *list {
+		w := tabwriter.NewWriter(stdout, 0, 8, 2, ' ', 0)
+		for _, p := range problems.All() {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", p.Slug, p.Category, p.Title, p.Complexity)
+		}
+		w.Flush()
+		return 0
+	}
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+	p, ok := problems.Lookup(flags.Arg(0))
+	if !ok {
+		p, ok = problems.LookupURL(flags.Arg(0))
+	}
+	if !ok {
+		fmt.Fprintf(stderr, "hackerrank: there is no solution to %q\n", flags.Arg(0))
+		return 2
+	}
+
+	w := bufio.NewWriter(stdout)
+	if err := p.Run(bufio.NewReader(stdin), w); err != nil {
+		fmt.Fprintf(stderr, "hackerrank: %s: %v\n", flags.Arg(0), err)
+		return 1
+	}
+	if err := w.Flush(); err != nil {
+		fmt.Fprintf(stderr, "hackerrank: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/abucarlo/hackerrank/interviews/harness"
+	"github.com/abucarlo/hackerrank/interviews/problems"
+)
+
+func TestSamples(t *testing.T) {
+	tests := []struct {
+		slug     string
+		input    string
+		expected string
+	}{
+		{"torque-and-development", "2\n3 3 2 1\n1 2\n3 1\n2 3\n6 6 2 5\n1 3\n3 4\n2 4\n1 2\n2 3\n5 6\n", "4\n12\n"},
+		{"two-strings", "2\nhello\nworld\nhi\nworld\n", "YES\nNO\n"},
+		{"flipping-bits", "3\n2147483647\n1\n0\n", "2147483648\n4294967294\n4294967295\n"},
+		{"making-candies", "3 1 2 12\n", "3\n"},
+		{"greedy-florist", "3 3\n2 5 6\n", "13\n"},
+		{"maximum-xor", "3\n0 1 2\n3\n3\n7\n2\n", "3\n7\n3\n"},
+		{"recursive-digit-sum", "148 3\n", "3\n"},
+		{"ctci-ice-cream-parlor", "2\n4\n5\n1 4 5 3 2\n4\n4\n2 2 4 3\n", "1 4\n1 2\n"},
+		{"ctci-fibonacci-numbers", "3\n", "2\n"},
+		{"ctci-recursive-staircase", "3\n1\n3\n7\n", "1\n4\n44\n"},
+		{"ctci-connected-cell-in-a-grid", "4\n4\n1 1 0 0\n0 1 1 0\n0 0 1 0\n1 0 0 0\n", "5\n"},
+		{"find-the-nearest-clone", "4 3\n1 2\n1 3\n4 2\n1 2 1 1\n1\n", "1\n"},
+		{"abbr", "1\ndaBcd\nABC\n", "YES\n"},
+		{"bfs-shortest-reach", "2\n4 2\n1 2\n1 3\n1\n3 1\n2 3\n2\n", "6 6 -1\n-1 6\n"},
+		{"castle-on-the-grid", "3\n.X.\n.X.\n...\n0 0 0 2\n", "3\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.slug, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			if status := run([]string{test.slug}, strings.NewReader(test.input), &stdout, &stderr); status != 0 {
+				t.Fatalf("Exit status %d: %s", status, stderr.String())
+			}
+			for _, d := range harness.Diff(test.expected, stdout.String()) {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+func TestFixtures(t *testing.T) {
+	for _, p := range problems.All() {
+		if p.Fixtures == "" {
+			continue
+		}
+		t.Run(p.Slug, func(t *testing.T) {
+			harness.Run(t, filepath.Join("../../interviews", p.Fixtures), func(r io.Reader, w io.Writer) error {
+				var stderr bytes.Buffer
+				if status := run([]string{p.Slug}, r, w, &stderr); status != 0 {
+					return fmt.Errorf("exit status %d: %s", status, stderr.String())
+				}
+				return nil
+			})
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		args   []string
+		input  string
+		status int
+		stderr string
+	}{
+		{[]string{}, "", 2, "usage: hackerrank"},
+		{[]string{"no-such-problem"}, "", 2, `there is no solution to "no-such-problem"`},
+		{[]string{"flipping-bits"}, "2\n1\nx\n", 1, "flipping-bits: line 3, column 1"},
+	}
+
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+		status := run(test.args, strings.NewReader(test.input), &stdout, &stderr)
+		if status != test.status || !strings.Contains(stderr.String(), test.stderr) {
+			t.Errorf("%v expected status %d and %q; got %d and %q", test.args, test.status, test.stderr, status, stderr.String())
+		}
+	}
+}
+
+func TestList(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if status := run([]string{"-list"}, nil, &stdout, &stderr); status != 0 {
+		t.Fatalf("Exit status %d: %s", status, stderr.String())
+	}
+	rows := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(rows) != len(problems.All()) || !strings.HasPrefix(rows[0], "abbr ") {
+		t.Errorf("Expected %d sorted problems; got %v", len(problems.All()), rows)
+	}
+}
+
+func TestURL(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	status := run([]string{"https://www.hackerrank.com/challenges/flipping-bits/problem"}, strings.NewReader("1\n0\n"), &stdout, &stderr)
+	if status != 0 || stdout.String() != "4294967295\n" {
+		t.Errorf("Expected 4294967295; got %d, %q, %q", status, stdout.String(), stderr.String())
+	}
+}
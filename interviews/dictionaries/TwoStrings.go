@@ -0,0 +1,57 @@
+package dictionaries
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/abucarlo/hackerrank/interviews/input"
+)
+
+// https://www.hackerrank.com/challenges/two-strings/problem
+
+// TwoStrings checks for the simplest common substring,
+// i.e. one common character.
+func TwoStrings(s string, t string) bool {
+	d := map[rune]bool{}
+	for _, c := range s {
+		d[c] = true
+	}
+	for _, c := range t {
+		if _, ok := d[c]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadTwoStrings reads HackerRank's input: the number
+// of pairs, followed by the pairs of strings.
+func ReadTwoStrings(r io.Reader) ([][2]string, error) {
+	in := input.NewScanner(r)
+	pairs := make([][2]string, in.Count(10))
+	for i := range pairs {
+		pairs[i] = [2]string{in.Word(), in.Word()}
+	}
+	if in.Err() != nil {
+		return nil, in.Err()
+	}
+	return pairs, nil
+}
+
+// SolveTwoStrings reads HackerRank's input and writes YES or NO for each pair.
+func SolveTwoStrings(r io.Reader, w io.Writer) error {
+	pairs, err := ReadTwoStrings(r)
+	if err != nil {
+		return err
+	}
+	for _, pair := range pairs {
+		answer := "NO"
+		if TwoStrings(pair[0], pair[1]) {
+			answer = "YES"
+		}
+		if _, err := fmt.Fprintln(w, answer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,25 +1,12 @@
 package dictionaries
 
+// https://www.hackerrank.com/challenges/two-strings/problem
+
 import (
 	"fmt"
 	"testing"
 )
 
-// TwoStrings checks for the simplest common substring,
-// i.e. one common character.
-func TwoStrings(s string, t string) bool {
-	d := map[rune]bool{}
-	for _, c := range s {
-		d[c] = true
-	}
-	for _, c := range t {
-		if _, ok := d[c]; ok {
-			return true
-		}
-	}
-	return false
-}
-
 func TestTwoStrings(t *testing.T) {
 	tests := []struct {
 		s      string
@@ -0,0 +1,3 @@
+// Package dictionaries solves the "Dictionaries and Hashmaps" problems
+// of the HackerRank Interview Preparation Kit.
+package dictionaries
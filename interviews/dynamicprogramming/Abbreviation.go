@@ -0,0 +1,92 @@
+package dynamicprogramming
+
+// https://www.hackerrank.com/challenges/abbr/problem
+
+import (
+	"fmt"
+	"io"
+	"unicode"
+
+	"github.com/abucarlo/hackerrank/interviews/input"
+)
+
+// Memo caches the result of matching the source from i against the target from j.
+type Memo map[int]map[int]bool
+
+func testEquality(memo Memo, source []rune, i int, target []rune, j int) bool {
+	sourceRune := source[i]
+	targetRune := target[j]
+	// Once we elect to delete a lower-case letter from the source, we have to delete them all.
+	match := sourceRune == targetRune || (unicode.ToUpper(sourceRune) == targetRune)
+	return match && abbreviateFrom(memo, source, i+1, target, j+1)
+}
+
+func testDeletion(memo Memo, source []rune, i int, target []rune, j int) bool {
+	sourceRune := source[i]
+	// If we're skipping characters..
+	return unicode.IsLower(sourceRune) && abbreviateFrom(memo, source, i+1, target, j)
+}
+
+func abbreviateFrom(memo Memo, source []rune, sourcePosition int, target []rune, targetPosition int) bool {
+	// Have we used up the source string?
+	if sourcePosition == len(source) {
+		return targetPosition == len(target)
+	}
+
+	// Check memoization.
+	if b, ok := memo[sourcePosition][targetPosition]; ok {
+		return b
+	}
+
+	// Don't short-circuit!
+	matchWithDeletion := testDeletion(memo, source, sourcePosition, target, targetPosition)
+	matchWithEquality := targetPosition < len(target) && testEquality(memo, source, sourcePosition, target, targetPosition)
+	result := matchWithDeletion || matchWithEquality
+	// fmt.Printf("Checking %s (%d) against %s (%d) with %t\n", string(source[sourcePosition:]), sourcePosition, string(target[targetPosition:]), targetPosition, result)
+	memo[sourcePosition][targetPosition] = result
+	return result
+}
+
+// Abbreviate is a version of "longest common subsequence".
+// See https://www.hackerrank.com/challenges/abbr/problem
+func Abbreviate(source string, target string) bool {
+	a := []rune(source)
+	b := []rune(target)
+	match := make(map[int]map[int]bool)
+	for i := range a {
+		match[i] = make(map[int]bool)
+	}
+	return abbreviateFrom(match, a, 0, b, 0)
+}
+
+// ReadAbbreviation reads HackerRank's input: the number of
+// queries, followed by pairs of source and target strings.
+func ReadAbbreviation(r io.Reader) ([][2]string, error) {
+	in := input.NewScanner(r)
+	pairs := make([][2]string, in.Count(10))
+	for i := range pairs {
+		pairs[i] = [2]string{in.Word(), in.Word()}
+	}
+	if in.Err() != nil {
+		return nil, in.Err()
+	}
+	return pairs, nil
+}
+
+// SolveAbbreviation reads HackerRank's input and writes YES or NO for each pair.
+func SolveAbbreviation(r io.Reader, w io.Writer) error {
+	pairs, err := ReadAbbreviation(r)
+	if err != nil {
+		return err
+	}
+	for _, pair := range pairs {
+		answer := "NO"
+		if Abbreviate(pair[0], pair[1]) {
+			answer = "YES"
+		}
+		if _, err := fmt.Fprintln(w, answer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -3,62 +3,10 @@ package dynamicprogramming
 // https://www.hackerrank.com/challenges/abbr/problem
 
 import (
-	"bufio"
-	"fmt"
-	"os"
-	"path/filepath"
-	"strconv"
 	"testing"
-	"unicode"
-)
-
-type Memo map[int]map[int]bool
-
-func testEquality(memo Memo, source []rune, i int, target []rune, j int) bool {
-	sourceRune := source[i]
-	targetRune := target[j]
-	// Once we elect to delete a lower-case letter from the source, we have to delete them all.
-	match := sourceRune == targetRune || (unicode.ToUpper(sourceRune) == targetRune)
-	return match && abbreviateFrom(memo, source, i+1, target, j+1)
-}
-
-func testDeletion(memo Memo, source []rune, i int, target []rune, j int) bool {
-	sourceRune := source[i]
-	// If we're skipping characters..
-	return unicode.IsLower(sourceRune) && abbreviateFrom(memo, source, i+1, target, j)
-}
-
-func abbreviateFrom(memo Memo, source []rune, sourcePosition int, target []rune, targetPosition int) bool {
-	// Have we used up the source string?
-	if sourcePosition == len(source) {
-		return targetPosition == len(target)
-	}
-
-	// Check memoization.
-	if b, ok := memo[sourcePosition][targetPosition]; ok {
-		return b
-	}
-
-	// Don't short-circuit!
-	matchWithDeletion := testDeletion(memo, source, sourcePosition, target, targetPosition)
-	matchWithEquality := targetPosition < len(target) && testEquality(memo, source, sourcePosition, target, targetPosition)
-	result := matchWithDeletion || matchWithEquality
-	// fmt.Printf("Checking %s (%d) against %s (%d) with %t\n", string(source[sourcePosition:]), sourcePosition, string(target[targetPosition:]), targetPosition, result)
-	memo[sourcePosition][targetPosition] = result
-	return result
-}
 
-// Abbreviate is a version of "longest common subsequence".
-// See https://www.hackerrank.com/challenges/abbr/problem
-func Abbreviate(source string, target string) bool {
-	a := []rune(source)
-	b := []rune(target)
-	match := make(map[int]map[int]bool)
-	for i := range a {
-		match[i] = make(map[int]bool)
-	}
-	return abbreviateFrom(match, a, 0, b, 0)
-}
+	"github.com/abucarlo/hackerrank/interviews/harness"
+)
 
 func TestAbbreviation(t *testing.T) {
 	tests := []struct {
@@ -89,24 +37,5 @@ func TestAbbreviation(t *testing.T) {
 }
 
 func TestAbbreviationTestCases(t *testing.T) {
-	name := "abbreviation-inputs/input12.txt"
-	path, _ := filepath.Abs(name)
-	file, e := os.Open(path)
-	if e != nil {
-		t.Error(e)
-	}
-	defer file.Close()
-	scanner := bufio.NewScanner(file)
-	scanner.Scan()
-	te := scanner.Text()
-	n, _ := strconv.Atoi(te)
-	for i := 0; i < n; i++ {
-		scanner.Scan()
-		source := scanner.Text()
-		scanner.Scan()
-		target := scanner.Text()
-		result := Abbreviate(source, target)
-		fmt.Printf("Result of %s... / %s...: %t\n", source[0:10], target[0:10], result)
-	}
+	harness.Run(t, "abbreviation-inputs", SolveAbbreviation)
 }
-
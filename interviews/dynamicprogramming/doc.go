@@ -0,0 +1,3 @@
+// Package dynamicprogramming solves the "Dynamic Programming" problems
+// of the HackerRank Interview Preparation Kit.
+package dynamicprogramming
@@ -0,0 +1,186 @@
+// Package export writes graphs in the Graphviz DOT and GraphML formats,
+// so that a graph from a failing test can be drawn rather than read.
+//
+// Each graph type converts itself to a Graph, which is then written by
+// WriteDOT or WriteGraphML.
+package export
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Attribute is a named value of a node. Values are integers, floats,
+// booleans or strings.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+type Node struct {
+	ID         string
+	Attributes []Attribute
+}
+
+type Edge struct {
+	From, To string
+}
+
+// Graph is a graph in a form that every format can write.
+type Graph struct {
+	Name     string
+	Directed bool
+	Nodes    []Node
+	Edges    []Edge
+	// Clusters optionally groups the IDs of nodes, e.g. by component.
+	Clusters [][]string
+}
+
+var numeral = regexp.MustCompile(`^-?(\.[0-9]+|[0-9]+(\.[0-9]*)?)$`)
+
+// quote returns a DOT ID: a numeral as is, and anything else quoted.
+func quote(s string) string {
+	if numeral.MatchString(s) {
+		return s
+	}
+	return strconv.Quote(s)
+}
+
+func writeNode(w *bufio.Writer, indent string, n Node) {
+	fmt.Fprintf(w, "%s%s", indent, quote(n.ID))
+	if len(n.Attributes) > 0 {
+		attributes := make([]string, len(n.Attributes))
+		for i, a := range n.Attributes {
+			attributes[i] = fmt.Sprintf("%s=%s", a.Key, quote(fmt.Sprint(a.Value)))
+		}
+		fmt.Fprintf(w, " [%s]", strings.Join(attributes, ", "))
+	}
+	w.WriteString(";\n")
+}
+
+// WriteDOT writes the graph in the Graphviz DOT language. Every cluster
+// becomes a subgraph whose name begins with "cluster", which Graphviz
+// draws in its own box.
+// See https://graphviz.org/doc/info/lang.html
+func WriteDOT(w io.Writer, g Graph) error {
+	b := bufio.NewWriter(w)
+	kind, arrow := "graph", "--"
+	if g.Directed {
+		kind, arrow = "digraph", "->"
+	}
+	fmt.Fprintf(b, "%s %s {\n", kind, quote(g.Name))
+
+	nodes := make(map[string]Node, len(g.Nodes))
+	for _, n := range g.Nodes {
+		nodes[n.ID] = n
+	}
+	clustered := make(map[string]bool)
+	for i, cluster := range g.Clusters {
+		fmt.Fprintf(b, "\tsubgraph cluster_%d {\n", i)
+		for _, id := range cluster {
+			clustered[id] = true
+			writeNode(b, "\t\t", nodes[id])
+		}
+		b.WriteString("\t}\n")
+	}
+	for _, n := range g.Nodes {
+		if !clustered[n.ID] {
+			writeNode(b, "\t", n)
+		}
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(b, "\t%s %s %s;\n", quote(e.From), arrow, quote(e.To))
+	}
+
+	b.WriteString("}\n")
+	return b.Flush()
+}
+
+// graphMLType returns the GraphML type of an attribute value.
+func graphMLType(v any) string {
+	switch v.(type) {
+	case int, int8, int16, int32, uint8, uint16:
+		return "int"
+	case int64, uint32, uint64, uint:
+		return "long"
+	case float32:
+		return "float"
+	case float64:
+		return "double"
+	case bool:
+		return "boolean"
+	default:
+		return "string"
+	}
+}
+
+func escape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// WriteGraphML writes the graph as GraphML. Attributes become keys for
+// nodes, and clusters become a "cluster" key holding the cluster's index.
+// See http://graphml.graphdrawing.org/specification.html
+func WriteGraphML(w io.Writer, g Graph) error {
+	b := bufio.NewWriter(w)
+	b.WriteString(xml.Header)
+	b.WriteString(`<graphml xmlns="http://graphml.graphdrawing.org/xmlns">` + "\n")
+
+	// Keys are declared in the order they first appear.
+	var keys []string
+	types := make(map[string]string)
+	for _, n := range g.Nodes {
+		for _, a := range n.Attributes {
+			if _, ok := types[a.Key]; !ok {
+				keys = append(keys, a.Key)
+				types[a.Key] = graphMLType(a.Value)
+			}
+		}
+	}
+	cluster := make(map[string]int)
+	for i, ids := range g.Clusters {
+		for _, id := range ids {
+			cluster[id] = i
+		}
+	}
+	if len(g.Clusters) > 0 {
+		keys = append(keys, "cluster")
+		types["cluster"] = "int"
+	}
+	for _, key := range keys {
+		fmt.Fprintf(b, "\t<key id=\"%s\" for=\"node\" attr.name=\"%s\" attr.type=\"%s\"/>\n", escape(key), escape(key), types[key])
+	}
+
+	direction := "undirected"
+	if g.Directed {
+		direction = "directed"
+	}
+	fmt.Fprintf(b, "\t<graph id=\"%s\" edgedefault=\"%s\">\n", escape(g.Name), direction)
+	for _, n := range g.Nodes {
+		i, clustered := cluster[n.ID]
+		if len(n.Attributes) == 0 && !clustered {
+			fmt.Fprintf(b, "\t\t<node id=\"%s\"/>\n", escape(n.ID))
+			continue
+		}
+		fmt.Fprintf(b, "\t\t<node id=\"%s\">\n", escape(n.ID))
+		for _, a := range n.Attributes {
+			fmt.Fprintf(b, "\t\t\t<data key=\"%s\">%s</data>\n", escape(a.Key), escape(fmt.Sprint(a.Value)))
+		}
+		if clustered {
+			fmt.Fprintf(b, "\t\t\t<data key=\"cluster\">%d</data>\n", i)
+		}
+		b.WriteString("\t\t</node>\n")
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(b, "\t\t<edge source=\"%s\" target=\"%s\"/>\n", escape(e.From), escape(e.To))
+	}
+	b.WriteString("\t</graph>\n</graphml>\n")
+	return b.Flush()
+}
@@ -0,0 +1,121 @@
+package export
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+var sample = Graph{
+	Name: "sample",
+	Nodes: []Node{
+		{"1", []Attribute{{"weight", int64(3)}, {"label", `a "b" <c>`}}},
+		{"2", nil},
+		{"x y", nil},
+	},
+	Edges:    []Edge{{"1", "2"}, {"2", "x y"}},
+	Clusters: [][]string{{"1", "2"}},
+}
+
+func TestWriteDOT(t *testing.T) {
+	var out strings.Builder
+	if err := WriteDOT(&out, sample); err != nil {
+		t.Fatal(err)
+	}
+	expected := `graph "sample" {
+	subgraph cluster_0 {
+		1 [weight=3, label="a \"b\" <c>"];
+		2;
+	}
+	"x y";
+	1 -- 2;
+	2 -- "x y";
+}
+`
+	if out.String() != expected {
+		t.Errorf("Expected\n%s\ngot\n%s", expected, out.String())
+	}
+
+	directed := sample
+	directed.Directed = true
+	out.Reset()
+	if err := WriteDOT(&out, directed); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(out.String(), "digraph") || !strings.Contains(out.String(), "1 -> 2;") {
+		t.Errorf("Expected a digraph; got\n%s", out.String())
+	}
+}
+
+func TestQuote(t *testing.T) {
+	for s, expected := range map[string]string{
+		"1": "1", "-12": "-12", ".5": ".5", "3.": "3.", "1e5": `"1e5"`, "NaN": `"NaN"`, "": `""`, "a": `"a"`,
+	} {
+		if actual := quote(s); actual != expected {
+			t.Errorf("%q should be %s; got %s", s, expected, actual)
+		}
+	}
+}
+
+func TestWriteGraphML(t *testing.T) {
+	var out strings.Builder
+	if err := WriteGraphML(&out, sample); err != nil {
+		t.Fatal(err)
+	}
+
+	// The output should be well-formed, and carry every attribute.
+	data := make(map[string]string)
+	types := make(map[string]string)
+	var node string
+	d := xml.NewDecoder(strings.NewReader(out.String()))
+	for {
+		token, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("%v in\n%s", err, out.String())
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		attributes := make(map[string]string)
+		for _, a := range start.Attr {
+			attributes[a.Name.Local] = a.Value
+		}
+		switch start.Name.Local {
+		case "key":
+			types[attributes["id"]] = attributes["attr.type"]
+		case "node":
+			node = attributes["id"]
+		case "data":
+			var value string
+			if err := d.DecodeElement(&value, &start); err != nil {
+				t.Fatal(err)
+			}
+			data[node+"."+attributes["key"]] = value
+		}
+	}
+
+	expectedTypes := map[string]string{"weight": "long", "label": "string", "cluster": "int"}
+	for key, expected := range expectedTypes {
+		if types[key] != expected {
+			t.Errorf("Key %s should be a %s; got %q", key, expected, types[key])
+		}
+	}
+	expectedData := map[string]string{"1.weight": "3", "1.label": `a "b" <c>`, "1.cluster": "0", "2.cluster": "0"}
+	if len(data) != len(expectedData) {
+		t.Errorf("Expected %v; got %v", expectedData, data)
+	}
+	for key, expected := range expectedData {
+		if data[key] != expected {
+			t.Errorf("%s should be %q; got %q", key, expected, data[key])
+		}
+	}
+	if !strings.Contains(out.String(), `<edge source="2" target="x y"/>`) {
+		t.Errorf("Expected an edge from 2 to x y; got\n%s", out.String())
+	}
+}
@@ -0,0 +1,248 @@
+package graphs
+
+/*
+	Shortest paths between every pair of vertices of a directed graph whose
+	edges may have negative weights, as long as no cycle does.
+
+	Floyd–Warshall takes O(V³) whatever the edges; Johnson's algorithm
+	reweights the edges with Bellman–Ford so that they are all non-negative,
+	then runs Dijkstra's algorithm from every vertex, for O(VE log V).
+
+	See https://en.wikipedia.org/wiki/Floyd%E2%80%93Warshall_algorithm
+	and https://en.wikipedia.org/wiki/Johnson%27s_algorithm
+*/
+
+import (
+	"container/heap"
+	"fmt"
+	"math/bits"
+	"slices"
+	"strings"
+)
+
+// NegativeCycleError reports a cycle whose weights add up to less than zero,
+// so that no path through it is shortest.
+type NegativeCycleError struct {
+	Cycle []int32
+}
+
+func (e *NegativeCycleError) Error() string {
+	s := make([]string, len(e.Cycle)+1)
+	for i, v := range e.Cycle {
+		s[i] = fmt.Sprint(v)
+	}
+	s[len(e.Cycle)] = s[0]
+	return "graph has a negative cycle: " + strings.Join(s, " -> ")
+}
+
+// AllPairs holds the shortest paths between every pair of vertices 0
+// through n - 1.
+type AllPairs[W Weight] struct {
+	order int32
+	// For the path from u to v, at index u*order + v: its weight, whether
+	// there is one, and the vertex before v on it.
+	distance []W
+	reached  []bool
+	parent   []int32
+}
+
+func newAllPairs[W Weight](order int32) *AllPairs[W] {
+	n := int(order) * int(order)
+	p := AllPairs[W]{order, make([]W, n), make([]bool, n), make([]int32, n)}
+	for v := range order {
+		p.reached[p.index(v, v)] = true
+		p.parent[p.index(v, v)] = v
+	}
+	return &p
+}
+
+// index is where the path from u to v is kept. It is an int, since order²
+// overflows an int32 above 46340 vertices.
+func (p *AllPairs[W]) index(u, v int32) int {
+	return int(u)*int(p.order) + int(v)
+}
+
+func (p *AllPairs[W]) Order() int32 {
+	return p.order
+}
+
+// Distance returns the weight of a shortest path from u to v, if there is one.
+func (p *AllPairs[W]) Distance(u, v int32) (W, bool) {
+	i := p.index(u, v)
+	return p.distance[i], p.reached[i]
+}
+
+// Path returns the vertices of a shortest path from u to v, from u to v, or
+// nil if there is none.
+func (p *AllPairs[W]) Path(u, v int32) []int32 {
+	if !p.reached[p.index(u, v)] {
+		return nil
+	}
+	path := []int32{v}
+	for v != u {
+		v = p.parent[p.index(u, v)]
+		path = append(path, v)
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// AllShortestPaths returns the shortest paths between every pair of the
+// vertices 0 through n - 1, joined by edges from U to V. It runs
+// Floyd–Warshall on dense graphs and Johnson's algorithm on sparse ones.
+func AllShortestPaths[W Weight](order int32, edges []Edge[W]) (*AllPairs[W], error) {
+	// Johnson's algorithm costs about E log V for every vertex, against V²
+	// for Floyd–Warshall.
+	if len(edges)*bits.Len32(uint32(order)) >= int(order)*int(order) {
+		return FloydWarshall(order, edges)
+	}
+	return Johnson(order, edges)
+}
+
+// FloydWarshall returns the shortest paths between every pair of the
+// vertices 0 through n - 1, joined by edges from U to V, or a
+// *NegativeCycleError.
+func FloydWarshall[W Weight](order int32, edges []Edge[W]) (*AllPairs[W], error) {
+	p := newAllPairs[W](order)
+	for _, e := range edges {
+		i := p.index(e.U, e.V)
+		if !p.reached[i] || e.Weight < p.distance[i] {
+			p.distance[i], p.reached[i], p.parent[i] = e.Weight, true, e.U
+		}
+	}
+
+	for k := range order {
+		for u := range order {
+			uk := p.index(u, k)
+			if !p.reached[uk] {
+				continue
+			}
+			for v := range order {
+				kv, uv := p.index(k, v), p.index(u, v)
+				if !p.reached[kv] {
+					continue
+				}
+				if d := p.distance[uk] + p.distance[kv]; !p.reached[uv] || d < p.distance[uv] {
+					p.distance[uv], p.reached[uv], p.parent[uv] = d, true, p.parent[kv]
+				}
+			}
+		}
+	}
+
+	for v := range order {
+		if p.distance[p.index(v, v)] < 0 {
+			_, cycle := bellmanFord(order, edges)
+			return nil, &NegativeCycleError{cycle}
+		}
+	}
+	return p, nil
+}
+
+// Johnson returns the shortest paths between every pair of the vertices 0
+// through n - 1, joined by edges from U to V, or a *NegativeCycleError.
+func Johnson[W Weight](order int32, edges []Edge[W]) (*AllPairs[W], error) {
+	potential, cycle := bellmanFord(order, edges)
+	if cycle != nil {
+		return nil, &NegativeCycleError{cycle}
+	}
+
+	// Every edge's weight goes up by the potential of its tail and down by
+	// that of its head, which makes it non-negative and changes every path
+	// from u to v by the same amount.
+	successors := make([][]Edge[W], order)
+	for _, e := range edges {
+		w := e.Weight + potential[e.U] - potential[e.V]
+		successors[e.U] = append(successors[e.U], Edge[W]{e.U, e.V, w})
+	}
+
+	p := newAllPairs[W](order)
+	for s := range order {
+		done := make([]bool, order)
+		h := &distanceHeap[W]{{s, 0}}
+		for h.Len() > 0 {
+			top := heap.Pop(h).(reach[W])
+			u := top.v
+			if done[u] {
+				continue
+			}
+			done[u] = true
+			for _, e := range successors[u] {
+				d := top.distance + e.Weight
+				if i := p.index(s, e.V); !p.reached[i] || d < p.distance[i] {
+					p.distance[i], p.reached[i], p.parent[i] = d, true, u
+					heap.Push(h, reach[W]{e.V, d})
+				}
+			}
+		}
+		for v := range order {
+			if i := p.index(s, v); p.reached[i] {
+				p.distance[i] += potential[v] - potential[s]
+			}
+		}
+	}
+	return p, nil
+}
+
+// bellmanFord returns the weight of a shortest path to every vertex from a
+// virtual vertex joined to all of them by edges of weight zero. If a
+// negative cycle is reachable, it returns that cycle instead.
+// See https://en.wikipedia.org/wiki/Bellman%E2%80%93Ford_algorithm
+func bellmanFord[W Weight](order int32, edges []Edge[W]) ([]W, []int32) {
+	distance := make([]W, order)
+	parent := make([]int32, order)
+	for v := range parent {
+		parent[v] = -1
+	}
+
+	// With no negative cycle, every shortest path has at most order edges,
+	// counting the one from the virtual vertex, so a change on the last
+	// round means there is one.
+	for round := range order {
+		changed := int32(-1)
+		for _, e := range edges {
+			if d := distance[e.U] + e.Weight; d < distance[e.V] {
+				distance[e.V], parent[e.V] = d, e.U
+				changed = e.V
+			}
+		}
+		if changed < 0 {
+			return distance, nil
+		}
+		if round < order-1 {
+			continue
+		}
+
+		// Following the parents from the changed vertex must enter the
+		// cycle within order steps.
+		v := changed
+		for range order {
+			v = parent[v]
+		}
+		cycle := []int32{v}
+		for u := parent[v]; u != v; u = parent[u] {
+			cycle = append(cycle, u)
+		}
+		slices.Reverse(cycle)
+		return nil, cycle
+	}
+	return distance, nil
+}
+
+// reach is a tentative distance to a vertex in Dijkstra's algorithm.
+type reach[W Weight] struct {
+	v        int32
+	distance W
+}
+
+type distanceHeap[W Weight] []reach[W]
+
+func (h distanceHeap[W]) Len() int           { return len(h) }
+func (h distanceHeap[W]) Less(i, j int) bool { return h[i].distance < h[j].distance }
+func (h distanceHeap[W]) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *distanceHeap[W]) Push(x any)        { *h = append(*h, x.(reach[W])) }
+func (h *distanceHeap[W]) Pop() any {
+	old := *h
+	r := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return r
+}
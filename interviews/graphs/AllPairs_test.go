@@ -0,0 +1,182 @@
+package graphs
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"pgregory.net/rapid"
+)
+
+// singleSource runs Bellman–Ford from source, and reports whether a negative
+// cycle is reachable from it.
+func singleSource(order, source int32, edges []Edge[int64]) ([]int64, bool) {
+	distance := make([]int64, order)
+	for v := range distance {
+		distance[v] = math.MaxInt64
+	}
+	distance[source] = 0
+	for range order {
+		for _, e := range edges {
+			if distance[e.U] != math.MaxInt64 && distance[e.U]+e.Weight < distance[e.V] {
+				distance[e.V] = distance[e.U] + e.Weight
+			}
+		}
+	}
+	for _, e := range edges {
+		if distance[e.U] != math.MaxInt64 && distance[e.U]+e.Weight < distance[e.V] {
+			return nil, true
+		}
+	}
+	return distance, false
+}
+
+// lightest returns the weight of the lightest edge from u to v.
+func lightest(edges []Edge[int64], u, v int32) (int64, bool) {
+	w, ok := int64(math.MaxInt64), false
+	for _, e := range edges {
+		if e.U == u && e.V == v {
+			w, ok = min(w, e.Weight), true
+		}
+	}
+	return w, ok
+}
+
+func checkAllPairs(t *rapid.T, order int32, edges []Edge[int64], p *AllPairs[int64], err error) {
+	var e *NegativeCycleError
+	if errors.As(err, &e) {
+		var total int64
+		for i, u := range e.Cycle {
+			w, ok := lightest(edges, u, e.Cycle[(i+1)%len(e.Cycle)])
+			if !ok {
+				t.Fatalf("%v, but there is no edge from %d", e, u)
+			}
+			total += w
+		}
+		if total >= 0 {
+			t.Fatalf("%v, but it weighs %d", e, total)
+		}
+	} else if err != nil {
+		t.Fatal(err)
+	}
+
+	for s := range order {
+		expected, negative := singleSource(order, s, edges)
+		if negative {
+			if err == nil {
+				t.Fatalf("Expected a negative cycle reachable from %d", s)
+			}
+			return
+		}
+		if err != nil {
+			continue
+		}
+		for v := range order {
+			d, ok := p.Distance(s, v)
+			if ok != (expected[v] != math.MaxInt64) || ok && d != expected[v] {
+				t.Fatalf("Expected a distance of %d from %d to %d; got %d, %t", expected[v], s, v, d, ok)
+			}
+			path := p.Path(s, v)
+			if !ok {
+				if path != nil {
+					t.Fatalf("Expected no path from %d to %d; got %v", s, v, path)
+				}
+				continue
+			}
+			if path[0] != s || path[len(path)-1] != v {
+				t.Fatalf("Path %v should run from %d to %d", path, s, v)
+			}
+			var total int64
+			for i := 1; i < len(path); i++ {
+				w, ok := lightest(edges, path[i-1], path[i])
+				if !ok {
+					t.Fatalf("Path %v has no edge from %d to %d", path, path[i-1], path[i])
+				}
+				total += w
+			}
+			if total != d {
+				t.Fatalf("Path %v weighs %d, not %d", path, total, d)
+			}
+		}
+	}
+	if err != nil {
+		t.Fatalf("Expected no negative cycle; got %v", err)
+	}
+}
+
+func TestAllPairs(t *testing.T) {
+	f := func(t *rapid.T) {
+		order := rapid.Int32Range(1, 8).Draw(t, "order")
+		vertex := rapid.Int32Range(0, order-1)
+		// Mostly non-negative weights, so that some graphs have negative
+		// edges but no negative cycle.
+		weight := rapid.Int64Range(-3, 20)
+		var edges []Edge[int64]
+		for range rapid.IntRange(0, 24).Draw(t, "size") {
+			edges = append(edges, Edge[int64]{vertex.Draw(t, "u"), vertex.Draw(t, "v"), weight.Draw(t, "weight")})
+		}
+
+		p, err := FloydWarshall(order, edges)
+		checkAllPairs(t, order, edges, p, err)
+		p, err = Johnson(order, edges)
+		checkAllPairs(t, order, edges, p, err)
+		p, err = AllShortestPaths(order, edges)
+		checkAllPairs(t, order, edges, p, err)
+	}
+
+	rapid.Check(t, f)
+}
+
+func TestNegativeCycleError(t *testing.T) {
+	edges := []Edge[int]{{0, 1, 1}, {1, 2, -1}, {2, 3, 2}, {3, 1, -2}}
+	for name, solve := range map[string]func(int32, []Edge[int]) (*AllPairs[int], error){
+		"FloydWarshall": FloydWarshall[int],
+		"Johnson":       Johnson[int],
+	} {
+		_, err := solve(4, edges)
+		if expected := "graph has a negative cycle: 1 -> 2 -> 3 -> 1"; err == nil || err.Error() != expected {
+			t.Errorf("%s: expected %q; got %v", name, expected, err)
+		}
+	}
+}
+
+// The distances between vertices of the same color, which SolveSubgraph
+// finds one source at a time, give the nearest clone.
+func TestAllPairsNearestClone(t *testing.T) {
+	f := func(t *rapid.T) {
+		order, from, to := edgeList(t)
+		colors := rapid.SliceOfN(rapid.Int32Range(1, 4), int(order), int(order)).Draw(t, "colors")
+		color := rapid.Int32Range(1, 4).Draw(t, "clone")
+
+		var edges []Edge[int32]
+		for i, u := range from {
+			edges = append(edges, Edge[int32]{u, to[i], 1}, Edge[int32]{to[i], u, 1})
+		}
+		p, err := AllShortestPaths(order, edges)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nearest := int32(-1)
+		for u := range order {
+			for v := range order {
+				if d, ok := p.Distance(u, v); ok && u != v && colors[u] == color && colors[v] == color && (nearest < 0 || d < nearest) {
+					nearest = d
+				}
+			}
+		}
+
+		if expected := NewCSRGraph(order, from, to).NearestClone(colors, color); nearest != expected {
+			t.Fatalf("Expected %d; got %d", expected, nearest)
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+// Indices past the range of an int32 must not wrap around.
+func TestAllPairsIndex(t *testing.T) {
+	p := &AllPairs[int]{order: 50000}
+	if i := p.index(49999, 49999); i != 49999*50000+49999 {
+		t.Errorf("Expected index %d; got %d", 49999*50000+49999, i)
+	}
+}
@@ -0,0 +1,137 @@
+package graphs
+
+/*
+	Which cities and roads are critical: an articulation point is a vertex,
+	and a bridge an edge, whose removal disconnects its component. The
+	biconnected components are the maximal pieces with no articulation
+	point of their own.
+
+	Tarjan's low-link values find all three in one depth-first search. The
+	search is iterative, so a long path cannot overflow the stack.
+
+	See https://en.wikipedia.org/wiki/Biconnected_component
+*/
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Biconnectivity describes the articulation points, bridges and biconnected
+// components of a graph. Everything is sorted, and a bridge or an edge
+// always has its lesser vertex first.
+type Biconnectivity struct {
+	ArticulationPoints []int32
+	Bridges            [][2]int32
+	// Components lists the vertices of every biconnected component. Two
+	// components share at most one vertex, an articulation point, and
+	// every bridge is a component of its own.
+	Components [][]int32
+}
+
+func orderedEdge(u, v int32) [2]int32 {
+	if u > v {
+		u, v = v, u
+	}
+	return [2]int32{u, v}
+}
+
+// Biconnected returns the articulation points, bridges and biconnected
+// components of the graph.
+// See https://en.wikipedia.org/wiki/Biconnected_component#Algorithms
+func (g *UndirectedGraph) Biconnected() Biconnectivity {
+	var b Biconnectivity
+	// discovered is when the search first reaches a vertex, and low the
+	// earliest vertex reachable from its subtree by at most one back edge.
+	discovered := make(map[int32]int32, len(g.adjacency))
+	low := make(map[int32]int32, len(g.adjacency))
+	articulation := NewSet[int32]()
+	// The edges of the components not yet complete.
+	var edges [][2]int32
+
+	type step struct {
+		v, parent int32
+		neighbors []int32
+		next      int
+	}
+
+	vertices := make([]int32, 0, len(g.adjacency))
+	for v := range g.adjacency {
+		vertices = append(vertices, v)
+	}
+	slices.Sort(vertices)
+
+	for _, root := range vertices {
+		if _, ok := discovered[root]; ok {
+			continue
+		}
+		discovered[root], low[root] = int32(len(discovered)), int32(len(discovered))
+		children := 0
+		stack := []step{{root, root, g.adjacency[root].Items(), 0}}
+
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			v := top.v
+			if top.next < len(top.neighbors) {
+				w := top.neighbors[top.next]
+				top.next++
+				if w == top.parent {
+					continue
+				}
+				if _, ok := discovered[w]; !ok {
+					discovered[w], low[w] = int32(len(discovered)), int32(len(discovered))
+					edges = append(edges, [2]int32{v, w})
+					stack = append(stack, step{w, v, g.adjacency[w].Items(), 0})
+				} else if discovered[w] < discovered[v] {
+					// A back edge to an ancestor.
+					low[v] = min(low[v], discovered[w])
+					edges = append(edges, [2]int32{v, w})
+				}
+				continue
+			}
+
+			stack = stack[:len(stack)-1]
+			if v == root {
+				continue
+			}
+			p := top.parent
+			low[p] = min(low[p], low[v])
+			if low[v] > discovered[p] {
+				b.Bridges = append(b.Bridges, orderedEdge(p, v))
+			}
+			if low[v] >= discovered[p] {
+				// Nothing below v reaches above p, so p separates v's
+				// subtree, and the edges from (p, v) on are a component.
+				if p == root {
+					children++
+				} else {
+					articulation.Add(p)
+				}
+				component := NewSet[int32]()
+				for {
+					e := edges[len(edges)-1]
+					edges = edges[:len(edges)-1]
+					component.Add(e[0])
+					component.Add(e[1])
+					if e == [2]int32{p, v} {
+						break
+					}
+				}
+				members := component.Items()
+				slices.Sort(members)
+				b.Components = append(b.Components, members)
+			}
+		}
+		if children > 1 {
+			articulation.Add(root)
+		}
+	}
+
+	b.ArticulationPoints = articulation.Items()
+	slices.Sort(b.ArticulationPoints)
+	slices.SortFunc(b.Bridges, func(x, y [2]int32) int {
+		return cmp.Or(cmp.Compare(x[0], y[0]), cmp.Compare(x[1], y[1]))
+	})
+	slices.SortFunc(b.Components, slices.Compare[[]int32])
+	return b
+}
@@ -0,0 +1,127 @@
+package graphs
+
+import (
+	"slices"
+	"testing"
+
+	"pgregory.net/rapid"
+)
+
+// countComponents counts the components of the graph without the given
+// vertex, or the given edge.
+func countComponents(g *UndirectedGraph, vertex int32, edge [2]int32) int {
+	disjoints := NewUnionFind[int32]()
+	for u, s := range g.adjacency {
+		if u == vertex {
+			continue
+		}
+		disjoints.Add(u)
+		for _, v := range s.Items() {
+			if v != vertex && orderedEdge(u, v) != edge {
+				disjoints.Union(u, v)
+			}
+		}
+	}
+	return disjoints.Count()
+}
+
+func TestBiconnected(t *testing.T) {
+	f := func(t *rapid.T) {
+		order := rapid.Int32Range(2, 20).Draw(t, "order")
+		vertex := rapid.Int32Range(1, order)
+		g := NewUndirectedGraph()
+		for range rapid.IntRange(1, 30).Draw(t, "size") {
+			u, v := vertex.Draw(t, "u"), vertex.Draw(t, "v")
+			if u != v {
+				g.Insert(u, v)
+			}
+		}
+		b := g.Biconnected()
+
+		none := [2]int32{}
+		components := countComponents(g, 0, none)
+		for u, s := range g.adjacency {
+			expected := countComponents(g, u, none) > components
+			if _, found := slices.BinarySearch(b.ArticulationPoints, u); found != expected {
+				t.Fatalf("Vertex %d should be an articulation point: %t; got %v", u, expected, b.ArticulationPoints)
+			}
+			for _, v := range s.Items() {
+				e := orderedEdge(u, v)
+				expected := countComponents(g, 0, e) > components
+				if slices.Contains(b.Bridges, e) != expected {
+					t.Fatalf("Edge %v should be a bridge: %t; got %v", e, expected, b.Bridges)
+				}
+			}
+		}
+
+		// Every edge is in exactly one component, and two components
+		// share at most one vertex.
+		for u, s := range g.adjacency {
+			for _, v := range s.Items() {
+				n := 0
+				for _, c := range b.Components {
+					if slices.Contains(c, u) && slices.Contains(c, v) {
+						n++
+					}
+				}
+				if n != 1 {
+					t.Fatalf("Edge (%d, %d) is in %d components: %v", u, v, n, b.Components)
+				}
+			}
+		}
+		for i, c := range b.Components {
+			for _, d := range b.Components[i+1:] {
+				shared := 0
+				for _, v := range c {
+					if slices.Contains(d, v) {
+						shared++
+					}
+				}
+				if shared > 1 {
+					t.Fatalf("Components %v and %v share %d vertices", c, d, shared)
+				}
+			}
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+func TestBiconnectedPathGraph(t *testing.T) {
+	// This is deep enough to overflow a recursive search.
+	const order = 100000
+	g := NewUndirectedGraph()
+	for v := int32(2); v <= order; v++ {
+		g.Insert(v-1, v)
+	}
+
+	b := g.Biconnected()
+	if len(b.ArticulationPoints) != order-2 || len(b.Bridges) != order-1 || len(b.Components) != order-1 {
+		t.Fatalf("A path of %d vertices should have %d articulation points, and %d bridges and components; got %d, %d and %d",
+			order, order-2, order-1, len(b.ArticulationPoints), len(b.Bridges), len(b.Components))
+	}
+	if b.ArticulationPoints[0] != 2 || b.Bridges[0] != [2]int32{1, 2} {
+		t.Errorf("Expected 2 and (1, 2) first; got %d and %v", b.ArticulationPoints[0], b.Bridges[0])
+	}
+
+	// Closing the path into a cycle leaves nothing critical.
+	g.Insert(order, 1)
+	b = g.Biconnected()
+	if len(b.ArticulationPoints) != 0 || len(b.Bridges) != 0 || len(b.Components) != 1 {
+		t.Errorf("A cycle should be biconnected; got %d articulation points, %d bridges and %d components",
+			len(b.ArticulationPoints), len(b.Bridges), len(b.Components))
+	}
+}
+
+func TestBiconnectedSample(t *testing.T) {
+	// Two triangles joined at 3, with a pendant road from 5 to 6.
+	g := NewUndirectedGraph()
+	for _, e := range [][2]int32{{1, 2}, {2, 3}, {3, 1}, {3, 4}, {4, 5}, {5, 3}, {5, 6}} {
+		g.Insert(e[0], e[1])
+	}
+	b := g.Biconnected()
+	if !slices.Equal(b.ArticulationPoints, []int32{3, 5}) || !slices.Equal(b.Bridges, [][2]int32{{5, 6}}) ||
+		!slices.EqualFunc(b.Components, [][]int32{{1, 2, 3}, {3, 4, 5}, {5, 6}}, slices.Equal[[]int32]) {
+		t.Errorf("Expected 3 and 5, (5, 6), and three components; got %+v", b)
+	}
+}
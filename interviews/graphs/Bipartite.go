@@ -0,0 +1,57 @@
+package graphs
+
+/*
+	A graph is bipartite exactly when it has no cycle of odd length. A
+	breadth-first search colors every vertex by the parity of its depth;
+	an edge between two vertices of the same color closes an odd cycle
+	through their lowest common ancestor in the search tree.
+
+	See https://en.wikipedia.org/wiki/Bipartite_graph#Testing_bipartiteness
+*/
+
+import "slices"
+
+// IsBipartite returns a 2-coloring of the graph, in which every edge joins
+// a vertex colored false to one colored true, and true. If the graph is not
+// bipartite, it returns an odd cycle instead, and false. The cycle lists its
+// vertices in order, and the last has an edge back to the first.
+func (g *UndirectedGraph) IsBipartite() (map[int32]bool, []int32, bool) {
+	side := make(map[int32]bool, len(g.adjacency))
+	parent := make(map[int32]int32, len(g.adjacency))
+	depth := make(map[int32]int32, len(g.adjacency))
+
+	for _, component := range g.components() {
+		root := slices.Min(component)
+		parent[root], depth[root], side[root] = root, 0, false
+		q := []int32{root}
+		for len(q) > 0 {
+			u := q[0]
+			q = q[1:]
+			for _, v := range g.adjacency[u].Items() {
+				if _, ok := depth[v]; !ok {
+					parent[v], depth[v] = u, depth[u]+1
+					side[v] = !side[u]
+					q = append(q, v)
+				} else if side[u] == side[v] {
+					return nil, oddCycle(parent, u, v), false
+				}
+			}
+		}
+	}
+	return side, nil, true
+}
+
+// oddCycle joins the paths from u and v up to their lowest common ancestor.
+// u and v are at the same depth, so the cycle has odd length.
+func oddCycle(parent map[int32]int32, u, v int32) []int32 {
+	up, down := []int32{u}, []int32{v}
+	for u != v {
+		u, v = parent[u], parent[v]
+		up = append(up, u)
+		down = append(down, v)
+	}
+	// Both paths end at the ancestor; keep it once.
+	down = down[:len(down)-1]
+	slices.Reverse(down)
+	return append(up, down...)
+}
@@ -0,0 +1,85 @@
+package graphs
+
+import (
+	"testing"
+
+	"pgregory.net/rapid"
+)
+
+// checkBipartite checks the coloring or the odd cycle that IsBipartite returns.
+func checkBipartite(t *rapid.T, g *UndirectedGraph) bool {
+	side, cycle, ok := g.IsBipartite()
+	if ok {
+		if len(side) != int(g.Order()) {
+			t.Fatalf("Expected a color for all %d vertices; got %d", g.Order(), len(side))
+		}
+		for u, s := range g.adjacency {
+			for _, v := range s.Items() {
+				if side[u] == side[v] {
+					t.Fatalf("Edge (%d, %d) joins two vertices colored %t", u, v, side[u])
+				}
+			}
+		}
+		return true
+	}
+
+	if len(cycle)%2 == 0 {
+		t.Fatalf("Cycle %v should have odd length", cycle)
+	}
+	seen := NewSet[int32]()
+	for i, u := range cycle {
+		if seen.Has(u) {
+			t.Fatalf("Cycle %v visits %d twice", cycle, u)
+		}
+		seen.Add(u)
+		if v := cycle[(i+1)%len(cycle)]; !g.adjacency[u].Has(v) {
+			t.Fatalf("Cycle %v has no edge (%d, %d)", cycle, u, v)
+		}
+	}
+	return false
+}
+
+func TestIsBipartite(t *testing.T) {
+	f := func(t *rapid.T) {
+		order := rapid.Int32Range(2, 30).Draw(t, "order")
+		vertex := rapid.Int32Range(1, order)
+		g := NewUndirectedGraph()
+		for range rapid.IntRange(1, 40).Draw(t, "size") {
+			u, v := vertex.Draw(t, "u"), vertex.Draw(t, "v")
+			if u != v {
+				g.Insert(u, v)
+			}
+		}
+		checkBipartite(t, g)
+	}
+
+	rapid.Check(t, f)
+}
+
+// https://en.wikipedia.org/wiki/Complete_bipartite_graph, plus an odd
+// cycle in another component when there is one.
+func TestCompleteBipartiteGraph(t *testing.T) {
+	f := func(t *rapid.T) {
+		m := rapid.Int32Range(1, 20).Draw(t, "m")
+		n := rapid.Int32Range(1, 20).Draw(t, "n")
+		g := NewUndirectedGraph()
+		for u := int32(1); u <= m; u++ {
+			for v := m + 1; v <= m+n; v++ {
+				g.Insert(u, v)
+			}
+		}
+		if !checkBipartite(t, g) {
+			t.Fatalf("K(%d, %d) should be bipartite", m, n)
+		}
+
+		cycle := shuffledVertices(3, 99).Draw(t, "cycle")
+		for i, u := range cycle {
+			g.Insert(-u, -cycle[(i+1)%len(cycle)])
+		}
+		if checkBipartite(t, g) != (len(cycle)%2 == 0) {
+			t.Fatalf("With a cycle of length %d, K(%d, %d) should be bipartite: %t", len(cycle), m, n, len(cycle)%2 == 0)
+		}
+	}
+
+	rapid.Check(t, f)
+}
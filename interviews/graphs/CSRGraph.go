@@ -0,0 +1,157 @@
+package graphs
+
+/*
+	An immutable undirected graph in compressed sparse row form: the
+	neighbors of every vertex are contiguous in one slice, so a search
+	touches a few flat arrays rather than a map of maps.
+
+	See https://en.wikipedia.org/wiki/Sparse_matrix#Compressed_sparse_row_(CSR,_CRS_or_Yale_format)
+*/
+
+import (
+	"fmt"
+	"math"
+)
+
+// CSRGraph is an undirected graph on the vertices 0 through n - 1. It may
+// have multiple edges and loops, exactly as they were given.
+type CSRGraph struct {
+	// The neighbors of v are neighbors[offsets[v]:offsets[v+1]].
+	offsets   []int32
+	neighbors []int32
+}
+
+// NewCSRGraph builds a graph of order vertices from HackerRank's parallel
+// arrays of edge endpoints. For vertices numbered from 1, pass an order
+// one greater, and vertex 0 is left isolated. It panics if an endpoint is
+// not a vertex.
+func NewCSRGraph(order int32, from, to []int32) *CSRGraph {
+	if len(from) != len(to) {
+		panic("from and to must have the same length")
+	}
+	g := CSRGraph{make([]int32, order+1), make([]int32, 2*len(from))}
+	for i, u := range from {
+		v := to[i]
+		if u < 0 || u >= order || v < 0 || v >= order {
+			panic(fmt.Sprintf("edge (%d, %d) is not between vertices 0 and %d", u, v, order-1))
+		}
+		g.offsets[u+1]++
+		g.offsets[v+1]++
+	}
+	for v := int32(1); v <= order; v++ {
+		g.offsets[v] += g.offsets[v-1]
+	}
+
+	// Fill every vertex's neighbors from its offset.
+	next := make([]int32, order)
+	copy(next, g.offsets)
+	for i, u := range from {
+		v := to[i]
+		g.neighbors[next[u]] = v
+		next[u]++
+		g.neighbors[next[v]] = u
+		next[v]++
+	}
+	return &g
+}
+
+func (g *CSRGraph) Order() int32 {
+	return int32(len(g.offsets) - 1)
+}
+
+func (g *CSRGraph) Size() int32 {
+	return int32(len(g.neighbors) / 2)
+}
+
+// Neighbors returns the neighbors of v, which must not be modified.
+func (g *CSRGraph) Neighbors(v int32) []int32 {
+	return g.neighbors[g.offsets[v]:g.offsets[v+1]]
+}
+
+// FindDisconnected returns the vertices of every connected component,
+// including isolated vertices. Every component begins with its least vertex.
+func (g *CSRGraph) FindDisconnected() [][]int32 {
+	visited := make([]bool, g.Order())
+	// All the components share one slice, in the order they are found.
+	order := make([]int32, 0, g.Order())
+	var components [][]int32
+	for root := range g.Order() {
+		if visited[root] {
+			continue
+		}
+		visited[root] = true
+		start := len(order)
+		order = append(order, root)
+		for i := start; i < len(order); i++ {
+			for _, v := range g.Neighbors(order[i]) {
+				if !visited[v] {
+					visited[v] = true
+					order = append(order, v)
+				}
+			}
+		}
+		components = append(components, order[start:len(order):len(order)])
+	}
+	return components
+}
+
+// Distances returns the length of the shortest path from source to every
+// vertex, or Unreachable, given the length of every edge.
+func (g *CSRGraph) Distances(source int32, length int32) []int32 {
+	distances := make([]int32, g.Order())
+	for v := range distances {
+		distances[v] = Unreachable
+	}
+	distances[source] = 0
+
+	q := make([]int32, 0, g.Order())
+	q = append(q, source)
+	for i := 0; i < len(q); i++ {
+		u := q[i]
+		for _, v := range g.Neighbors(u) {
+			if distances[v] == Unreachable {
+				distances[v] = distances[u] + length
+				q = append(q, v)
+			}
+		}
+	}
+	return distances
+}
+
+// NearestClone returns the length of the shortest path between two vertices
+// of the given color, or -1 if there is none, where colors[v] is the color
+// of v. It searches from every vertex of the color at once, like
+// ColoredGraph.NearestClone.
+func (g *CSRGraph) NearestClone(colors []int32, color int32) int32 {
+	source := make([]int32, g.Order())
+	distance := make([]int32, g.Order())
+	q := make([]int32, 0, g.Order())
+	for v := range g.Order() {
+		source[v] = -1
+		if colors[v] == color {
+			source[v] = v
+			q = append(q, v)
+		}
+	}
+
+	closestClone := int32(math.MaxInt32)
+	for i := 0; i < len(q); i++ {
+		u := q[i]
+		if 2*distance[u]+1 >= closestClone {
+			break
+		}
+		for _, v := range g.Neighbors(u) {
+			if source[v] == -1 {
+				source[v], distance[v] = source[u], distance[u]+1
+				q = append(q, v)
+			} else if source[v] != source[u] {
+				closestClone = min(closestClone, distance[u]+distance[v]+1)
+			}
+		}
+	}
+
+	if closestClone == math.MaxInt32 {
+		return -1
+	}
+	return closestClone
+}
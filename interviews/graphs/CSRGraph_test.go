@@ -0,0 +1,220 @@
+package graphs
+
+import (
+	"os"
+	"slices"
+	"testing"
+
+	"pgregory.net/rapid"
+)
+
+// edgeList generates the endpoints of edges among the vertices 0 through n - 1,
+// with no loops.
+func edgeList(t *rapid.T) (int32, []int32, []int32) {
+	order := rapid.Int32Range(1, 30).Draw(t, "order")
+	vertex := rapid.Int32Range(0, order-1)
+	var from, to []int32
+	for range rapid.IntRange(0, 60).Draw(t, "size") {
+		u, v := vertex.Draw(t, "u"), vertex.Draw(t, "v")
+		if u != v {
+			from, to = append(from, u), append(to, v)
+		}
+	}
+	return order, from, to
+}
+
+func TestCSRGraph(t *testing.T) {
+	f := func(t *rapid.T) {
+		order, from, to := edgeList(t)
+		g := NewCSRGraph(order, from, to)
+		h := NewUndirectedGraph()
+		for i, u := range from {
+			h.Insert(u, to[i])
+		}
+
+		if g.Order() != order || g.Size() != int32(len(from)) {
+			t.Fatalf("Expected %d vertices and %d edges; got %d and %d", order, len(from), g.Order(), g.Size())
+		}
+		for v := range order {
+			neighbors := slices.Clone(g.Neighbors(v))
+			slices.Sort(neighbors)
+			neighbors = slices.Compact(neighbors)
+			var expected []int32
+			if s, ok := h.adjacency[v]; ok {
+				expected = s.Items()
+				slices.Sort(expected)
+			}
+			if !slices.Equal(neighbors, expected) {
+				t.Fatalf("Vertex %d should have neighbors %v; got %v", v, expected, neighbors)
+			}
+		}
+
+		// Isolated vertices are components of the CSR graph, but are not
+		// in the UndirectedGraph at all.
+		components := g.FindDisconnected()
+		isolated := order - h.Order()
+		if int32(len(components)) != int32(len(h.FindDisconnected()))+isolated {
+			t.Fatalf("Expected %d components and %d isolated vertices; got %d", len(h.FindDisconnected()), isolated, len(components))
+		}
+		seen := make([]bool, order)
+		for _, c := range components {
+			if slices.Min(c) != c[0] {
+				t.Fatalf("Component %v should begin with its least vertex", c)
+			}
+			for _, v := range c {
+				if seen[v] {
+					t.Fatalf("Vertex %d is in two components", v)
+				}
+				seen[v] = true
+			}
+		}
+
+		source := rapid.Int32Range(0, order-1).Draw(t, "source")
+		distances := g.Distances(source, EdgeLength)
+		expected := h.Distances(source, EdgeLength)
+		for v := range order {
+			d, ok := expected[v]
+			if !ok {
+				d = Unreachable
+			}
+			if distances[v] != d {
+				t.Fatalf("Vertex %d should be at %d from %d; got %d", v, d, source, distances[v])
+			}
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+func TestCSRNearestClone(t *testing.T) {
+	f := func(t *rapid.T) {
+		order, from, to := edgeList(t)
+		colors := rapid.SliceOfN(rapid.Int32Range(1, 4), int(order), int(order)).Draw(t, "colors")
+		color := rapid.Int32Range(1, 4).Draw(t, "clone")
+
+		h := NewColoredGraph()
+		for i, u := range from {
+			h.AddEdge(u, to[i])
+		}
+		for v, c := range colors {
+			h.SetColor(int32(v), c)
+		}
+
+		expected := h.NearestClone(color)
+		if actual := NewCSRGraph(order, from, to).NearestClone(colors, color); actual != expected {
+			t.Fatalf("Expected %d; got %d", expected, actual)
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+// loadMatrix reads the roads of the largest Matrix input as parallel arrays.
+func loadMatrix(b *testing.B) (int32, []int32, []int32) {
+	f, err := os.Open("./matrix-inputs/input05.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer f.Close()
+	p, err := ReadMatrix(f)
+	if err != nil {
+		b.Fatal(err)
+	}
+	from, to := make([]int32, len(p.Roads)), make([]int32, len(p.Roads))
+	for i, road := range p.Roads {
+		from[i], to[i] = road.U, road.V
+	}
+	return p.Order, from, to
+}
+
+func BenchmarkBuild(b *testing.B) {
+	order, from, to := loadMatrix(b)
+	b.Run("CSRGraph", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			NewCSRGraph(order, from, to)
+		}
+	})
+	b.Run("UndirectedGraph", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			g := NewUndirectedGraph()
+			for j, u := range from {
+				g.Insert(u, to[j])
+			}
+		}
+	})
+}
+
+func BenchmarkFindDisconnected(b *testing.B) {
+	order, from, to := loadMatrix(b)
+	csr := NewCSRGraph(order, from, to)
+	g := NewUndirectedGraph()
+	for j, u := range from {
+		g.Insert(u, to[j])
+	}
+
+	b.Run("CSRGraph", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			csr.FindDisconnected()
+		}
+	})
+	b.Run("UndirectedGraph", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			g.FindDisconnected()
+		}
+	})
+}
+
+func BenchmarkDistances(b *testing.B) {
+	order, from, to := loadMatrix(b)
+	csr := NewCSRGraph(order, from, to)
+	g := NewUndirectedGraph()
+	for j, u := range from {
+		g.Insert(u, to[j])
+	}
+
+	b.Run("CSRGraph", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			csr.Distances(0, EdgeLength)
+		}
+	})
+	b.Run("UndirectedGraph", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			g.Distances(0, EdgeLength)
+		}
+	})
+}
+
+func BenchmarkCSRNearestClone(b *testing.B) {
+	f, err := os.Open(directory + "/input04.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer f.Close()
+	g, color, err := ReadFindClone(f)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	// Vertices are numbered from 1, so vertex 0 is isolated.
+	var from, to []int32
+	for u, s := range g.adjacency {
+		for _, v := range s.Items() {
+			if u < v {
+				from, to = append(from, u), append(to, v)
+			}
+		}
+	}
+	order := int32(len(g.colors))
+	csr := NewCSRGraph(order, from, to)
+
+	b.Run("CSRGraph", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			csr.NearestClone(g.colors, color)
+		}
+	})
+	b.Run("ColoredGraph", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			g.NearestClone(color)
+		}
+	})
+}
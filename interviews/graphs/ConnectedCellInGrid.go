@@ -0,0 +1,57 @@
+package graphs
+
+/*
+	https://www.hackerrank.com/challenges/ctci-connected-cell-in-a-grid/problem
+
+	The problem is to find the largest region of a grid whose cells
+	connect to all 8 of their neighbors; Grid labels every region.
+*/
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/abucarlo/hackerrank/interviews/input"
+)
+
+// MaxRegion returns the size of the largest region of 1s in the grid,
+// where cells touching horizontally, vertically or diagonally are connected.
+func MaxRegion(grid [][]int32) int32 {
+	var result int32
+	for _, r := range NewGrid(grid, EightConnected).Regions().Regions {
+		result = max(result, r.Size)
+	}
+	return result
+}
+
+// ReadGrid reads HackerRank's input: the number of rows, the number
+// of columns, then the cells, each of which is 0 or 1.
+func ReadGrid(r io.Reader) ([][]int32, error) {
+	in := input.NewScanner(r)
+	n := in.Count(1000)
+	m := in.Count(1000)
+	grid := make([][]int32, n)
+	for i := range grid {
+		grid[i] = make([]int32, m)
+		for j := range grid[i] {
+			grid[i][j] = in.Int32()
+			if in.Err() == nil && grid[i][j] != 0 && grid[i][j] != 1 {
+				in.Errorf("cell (%d, %d) is %d, not 0 or 1", i, j, grid[i][j])
+			}
+		}
+	}
+	if in.Err() != nil {
+		return nil, in.Err()
+	}
+	return grid, nil
+}
+
+// SolveMaxRegion reads HackerRank's input and writes the size of the largest region.
+func SolveMaxRegion(r io.Reader, w io.Writer) error {
+	grid, err := ReadGrid(r)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, MaxRegion(grid))
+	return err
+}
@@ -0,0 +1,323 @@
+package graphs
+
+/*
+	Topological sort and strongly connected components of a directed graph.
+
+	Every search here is iterative, so a long path cannot overflow the stack.
+	Vertices and their successors are visited in ascending order, so the
+	results are the same from one run to the next.
+
+	See https://en.wikipedia.org/wiki/Strongly_connected_component
+*/
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DirectedGraph is a graph whose edges have a direction. It may have
+// loops, but not multiple edges from one vertex to another.
+type DirectedGraph struct {
+	successors map[int32]*Set[int32]
+}
+
+func NewDirectedGraph() *DirectedGraph {
+	return &DirectedGraph{make(map[int32]*Set[int32])}
+}
+
+// AddVertex adds a vertex with no edges.
+func (g *DirectedGraph) AddVertex(v int32) {
+	if _, ok := g.successors[v]; !ok {
+		g.successors[v] = NewSet[int32]()
+	}
+}
+
+// Insert adds an edge from u to v.
+func (g *DirectedGraph) Insert(u, v int32) {
+	g.AddVertex(u)
+	g.AddVertex(v)
+	g.successors[u].Add(v)
+}
+
+func (g *DirectedGraph) Order() int32 {
+	return int32(len(g.successors))
+}
+
+func (g *DirectedGraph) Size() int32 {
+	result := 0
+	for _, s := range g.successors {
+		result += s.Size()
+	}
+	return int32(result)
+}
+
+// Has reports whether there is an edge from u to v.
+func (g *DirectedGraph) Has(u, v int32) bool {
+	s, ok := g.successors[u]
+	return ok && s.Has(v)
+}
+
+// Vertices returns every vertex in ascending order.
+func (g *DirectedGraph) Vertices() []int32 {
+	result := make([]int32, 0, len(g.successors))
+	for v := range g.successors {
+		result = append(result, v)
+	}
+	slices.Sort(result)
+	return result
+}
+
+// Successors returns the heads of the edges leaving v in ascending order.
+func (g *DirectedGraph) Successors(v int32) []int32 {
+	s, ok := g.successors[v]
+	if !ok {
+		return nil
+	}
+	result := s.Items()
+	slices.Sort(result)
+	return result
+}
+
+// Reverse returns the graph with every edge turned around.
+func (g *DirectedGraph) Reverse() *DirectedGraph {
+	r := NewDirectedGraph()
+	for u, s := range g.successors {
+		r.AddVertex(u)
+		for v := range s.m {
+			r.Insert(v, u)
+		}
+	}
+	return r
+}
+
+// CycleError reports that a graph has no topological order.
+type CycleError struct {
+	// Cycle lists the vertices of a cycle in the order of its edges;
+	// the last one has an edge back to the first.
+	Cycle []int32
+}
+
+func (e *CycleError) Error() string {
+	var b strings.Builder
+	b.WriteString("graph has a cycle: ")
+	for _, v := range e.Cycle {
+		fmt.Fprintf(&b, "%d -> ", v)
+	}
+	fmt.Fprintf(&b, "%d", e.Cycle[0])
+	return b.String()
+}
+
+// frame is a vertex on the stack of a depth-first search, with the index
+// of the next of its successors to visit.
+type frame struct {
+	v          int32
+	successors []int32
+	next       int
+}
+
+// TopologicalSort returns the vertices ordered so that every edge goes
+// from an earlier vertex to a later one. If there is no such order, it
+// returns a *CycleError with one of the cycles.
+// See https://en.wikipedia.org/wiki/Topological_sorting#Depth-first_search
+func (g *DirectedGraph) TopologicalSort() ([]int32, error) {
+	const (
+		unvisited = iota
+		visiting
+		finished
+	)
+	state := make(map[int32]int, len(g.successors))
+	result := make([]int32, 0, len(g.successors))
+
+	for _, root := range g.Vertices() {
+		if state[root] != unvisited {
+			continue
+		}
+		state[root] = visiting
+		stack := []frame{{root, g.Successors(root), 0}}
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			if top.next == len(top.successors) {
+				state[top.v] = finished
+				result = append(result, top.v)
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			v := top.successors[top.next]
+			top.next++
+			switch state[v] {
+			case unvisited:
+				state[v] = visiting
+				stack = append(stack, frame{v, g.Successors(v), 0})
+			case visiting:
+				// v is on the stack, so the stack from v up is a cycle.
+				var cycle []int32
+				for i := len(stack) - 1; stack[i].v != v; i-- {
+					cycle = append(cycle, stack[i].v)
+				}
+				cycle = append(cycle, v)
+				slices.Reverse(cycle)
+				return nil, &CycleError{cycle}
+			}
+		}
+	}
+
+	// Every vertex finishes after everything reachable from it.
+	slices.Reverse(result)
+	return result, nil
+}
+
+// Tarjan returns the strongly connected components of the graph, each in
+// ascending order. The components are in topological order: no edge goes
+// from a component to an earlier one.
+// See https://en.wikipedia.org/wiki/Tarjan%27s_strongly_connected_components_algorithm
+func (g *DirectedGraph) Tarjan() [][]int32 {
+	index := make(map[int32]int32, len(g.successors))
+	lowlink := make(map[int32]int32, len(g.successors))
+	onStack := NewSet[int32]()
+	var visited []int32
+	var components [][]int32
+
+	for _, root := range g.Vertices() {
+		if _, ok := index[root]; ok {
+			continue
+		}
+		index[root], lowlink[root] = int32(len(index)), int32(len(index))
+		visited = append(visited, root)
+		onStack.Add(root)
+		stack := []frame{{root, g.Successors(root), 0}}
+
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			if top.next < len(top.successors) {
+				v := top.successors[top.next]
+				top.next++
+				if _, ok := index[v]; !ok {
+					index[v], lowlink[v] = int32(len(index)), int32(len(index))
+					visited = append(visited, v)
+					onStack.Add(v)
+					stack = append(stack, frame{v, g.Successors(v), 0})
+				} else if onStack.Has(v) {
+					lowlink[top.v] = min(lowlink[top.v], index[v])
+				}
+				continue
+			}
+
+			u := top.v
+			stack = stack[:len(stack)-1]
+			if len(stack) > 0 {
+				parent := stack[len(stack)-1].v
+				lowlink[parent] = min(lowlink[parent], lowlink[u])
+			}
+			if lowlink[u] == index[u] {
+				// u is the root of a component: everything visited since.
+				i := len(visited) - 1
+				for visited[i] != u {
+					i--
+				}
+				component := slices.Clone(visited[i:])
+				for _, v := range component {
+					onStack.Remove(v)
+				}
+				visited = visited[:i]
+				slices.Sort(component)
+				components = append(components, component)
+			}
+		}
+	}
+
+	// Tarjan's algorithm finds a component only after every component
+	// reachable from it.
+	slices.Reverse(components)
+	return components
+}
+
+// Kosaraju returns the strongly connected components of the graph, each in
+// ascending order. The components are in topological order: no edge goes
+// from a component to an earlier one.
+// See https://en.wikipedia.org/wiki/Kosaraju%27s_algorithm
+func (g *DirectedGraph) Kosaraju() [][]int32 {
+	// First, order the vertices by when a search of the graph finishes them.
+	finished := make([]int32, 0, len(g.successors))
+	visited := NewSet[int32]()
+	for _, root := range g.Vertices() {
+		if visited.Has(root) {
+			continue
+		}
+		visited.Add(root)
+		stack := []frame{{root, g.Successors(root), 0}}
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			if top.next == len(top.successors) {
+				finished = append(finished, top.v)
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			v := top.successors[top.next]
+			top.next++
+			if !visited.Has(v) {
+				visited.Add(v)
+				stack = append(stack, frame{v, g.Successors(v), 0})
+			}
+		}
+	}
+
+	// Then, in reverse order of finishing, everything that reaches a
+	// vertex in the reverse graph and is not yet assigned is its component.
+	reverse := g.Reverse()
+	visited.Clear()
+	var components [][]int32
+	for i := len(finished) - 1; i >= 0; i-- {
+		root := finished[i]
+		if visited.Has(root) {
+			continue
+		}
+		visited.Add(root)
+		component := []int32{root}
+		for q := []int32{root}; len(q) > 0; {
+			u := q[len(q)-1]
+			q = q[:len(q)-1]
+			for v := range reverse.successors[u].m {
+				if !visited.Has(v) {
+					visited.Add(v)
+					component = append(component, v)
+					q = append(q, v)
+				}
+			}
+		}
+		slices.Sort(component)
+		components = append(components, component)
+	}
+	return components
+}
+
+// Condensation is the graph with every strongly connected component
+// contracted to a single vertex. It is always acyclic.
+// See https://en.wikipedia.org/wiki/Strongly_connected_component#Definitions
+type Condensation struct {
+	// Components are in topological order, and the vertex of the
+	// DAG for Components[i] is i.
+	Components [][]int32
+	// Component maps every vertex of the graph to its component.
+	Component map[int32]int32
+	DAG       *DirectedGraph
+}
+
+// Condense returns the condensation of the graph.
+func (g *DirectedGraph) Condense() Condensation {
+	c := Condensation{g.Tarjan(), make(map[int32]int32, len(g.successors)), NewDirectedGraph()}
+	for i, component := range c.Components {
+		c.DAG.AddVertex(int32(i))
+		for _, v := range component {
+			c.Component[v] = int32(i)
+		}
+	}
+	for u, s := range g.successors {
+		for v := range s.m {
+			if x, y := c.Component[u], c.Component[v]; x != y {
+				c.DAG.Insert(x, y)
+			}
+		}
+	}
+	return c
+}
@@ -0,0 +1,217 @@
+package graphs
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"pgregory.net/rapid"
+)
+
+// directedGraph generates a graph on the vertices 1 through n, where
+// every vertex may be isolated and there may be loops.
+func directedGraph(t *rapid.T) *DirectedGraph {
+	order := rapid.Int32Range(1, 30).Draw(t, "order")
+	vertex := rapid.Int32Range(1, order)
+	g := NewDirectedGraph()
+	for v := int32(1); v <= order; v++ {
+		g.AddVertex(v)
+	}
+	for range rapid.IntRange(0, 60).Draw(t, "size") {
+		g.Insert(vertex.Draw(t, "u"), vertex.Draw(t, "v"))
+	}
+	return g
+}
+
+// reachable returns every vertex reachable from u, including u.
+func reachable(g *DirectedGraph, u int32) *Set[int32] {
+	result := NewSet[int32]()
+	result.Add(u)
+	for q := []int32{u}; len(q) > 0; q = q[1:] {
+		for _, v := range g.Successors(q[0]) {
+			if !result.Has(v) {
+				result.Add(v)
+				q = append(q, v)
+			}
+		}
+	}
+	return result
+}
+
+// checkComponents checks that components partition the vertices, that
+// two vertices are in the same component exactly when each reaches the
+// other, and that the components are in topological order.
+func checkComponents(t *rapid.T, g *DirectedGraph, name string, components [][]int32) {
+	component := make(map[int32]int)
+	for i, c := range components {
+		if !slices.IsSorted(c) {
+			t.Fatalf("%s: component %v is not sorted", name, c)
+		}
+		for _, v := range c {
+			if _, ok := component[v]; ok {
+				t.Fatalf("%s: vertex %d is in two components", name, v)
+			}
+			component[v] = i
+		}
+	}
+	if len(component) != int(g.Order()) {
+		t.Fatalf("%s: components cover %d of %d vertices", name, len(component), g.Order())
+	}
+
+	reaches := make(map[int32]*Set[int32])
+	for _, u := range g.Vertices() {
+		reaches[u] = reachable(g, u)
+	}
+	for _, u := range g.Vertices() {
+		for _, v := range g.Vertices() {
+			strong := reaches[u].Has(v) && reaches[v].Has(u)
+			if strong != (component[u] == component[v]) {
+				t.Fatalf("%s: %d and %d are strongly connected: %t, but in components %d and %d", name, u, v, strong, component[u], component[v])
+			}
+		}
+		for _, v := range g.Successors(u) {
+			if component[u] > component[v] {
+				t.Fatalf("%s: edge (%d, %d) goes back from component %d to %d", name, u, v, component[u], component[v])
+			}
+		}
+	}
+}
+
+// canonical orders components by their least vertex.
+func canonical(components [][]int32) [][]int32 {
+	result := slices.Clone(components)
+	slices.SortFunc(result, func(a, b []int32) int { return int(a[0] - b[0]) })
+	return result
+}
+
+func TestStronglyConnectedComponents(t *testing.T) {
+	f := func(t *rapid.T) {
+		g := directedGraph(t)
+		tarjan, kosaraju := g.Tarjan(), g.Kosaraju()
+		checkComponents(t, g, "Tarjan", tarjan)
+		checkComponents(t, g, "Kosaraju", kosaraju)
+
+		if !slices.EqualFunc(canonical(tarjan), canonical(kosaraju), slices.Equal[[]int32]) {
+			t.Fatalf("Tarjan and Kosaraju should agree; got\n%v\n%v", tarjan, kosaraju)
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+func TestCondense(t *testing.T) {
+	f := func(t *rapid.T) {
+		g := directedGraph(t)
+		c := g.Condense()
+
+		if c.DAG.Order() != int32(len(c.Components)) {
+			t.Fatalf("Expected %d vertices in the DAG; got %d", len(c.Components), c.DAG.Order())
+		}
+		order, err := c.DAG.TopologicalSort()
+		if err != nil {
+			t.Fatalf("The condensation should be acyclic: %v", err)
+		}
+		if len(order) != len(c.Components) {
+			t.Fatalf("Expected %d components in topological order; got %v", len(c.Components), order)
+		}
+		for _, u := range g.Vertices() {
+			for _, v := range g.Successors(u) {
+				x, y := c.Component[u], c.Component[v]
+				if x != y && !c.DAG.Has(x, y) {
+					t.Fatalf("Edge (%d, %d) should be edge (%d, %d) in the DAG", u, v, x, y)
+				}
+				if x > y {
+					t.Fatalf("Edge (%d, %d) goes back from component %d to %d", u, v, x, y)
+				}
+			}
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+func TestTopologicalSort(t *testing.T) {
+	f := func(t *rapid.T) {
+		g := directedGraph(t)
+		order, err := g.TopologicalSort()
+
+		var cycle *CycleError
+		if errors.As(err, &cycle) {
+			for i, u := range cycle.Cycle {
+				if v := cycle.Cycle[(i+1)%len(cycle.Cycle)]; !g.Has(u, v) {
+					t.Fatalf("%v: there is no edge (%d, %d)", err, u, v)
+				}
+			}
+			return
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		position := make(map[int32]int)
+		for i, v := range order {
+			position[v] = i
+		}
+		if len(position) != int(g.Order()) {
+			t.Fatalf("Expected all %d vertices; got %v", g.Order(), order)
+		}
+		for _, u := range g.Vertices() {
+			for _, v := range g.Successors(u) {
+				if position[u] >= position[v] {
+					t.Fatalf("Edge (%d, %d) goes backwards in %v", u, v, order)
+				}
+			}
+		}
+		// Without a cycle, every component is a single vertex without a loop.
+		for _, c := range g.Tarjan() {
+			if len(c) > 1 || g.Has(c[0], c[0]) {
+				t.Fatalf("%v is sorted, but %v is on a cycle", order, c)
+			}
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+func TestTopologicalSortCycle(t *testing.T) {
+	g := NewDirectedGraph()
+	for _, e := range [][2]int32{{1, 2}, {2, 3}, {3, 4}, {4, 2}, {1, 5}} {
+		g.Insert(e[0], e[1])
+	}
+	_, err := g.TopologicalSort()
+	if expected := "graph has a cycle: 2 -> 3 -> 4 -> 2"; err == nil || err.Error() != expected {
+		t.Errorf("Expected %q; got %v", expected, err)
+	}
+
+	// A DAG is its own condensation.
+	g = NewDirectedGraph()
+	for _, e := range [][2]int32{{1, 2}, {1, 3}, {2, 4}, {3, 4}} {
+		g.Insert(e[0], e[1])
+	}
+	if c := g.Condense(); c.DAG.Order() != 4 || c.DAG.Size() != 4 {
+		t.Errorf("Expected a DAG of 4 vertices and 4 edges; got %d and %d", c.DAG.Order(), c.DAG.Size())
+	}
+}
+
+func TestDirectedPathGraph(t *testing.T) {
+	f := func(t *rapid.T) {
+		vertices := shuffledVertices(2, 9999).Draw(t, "path")
+		g := NewDirectedGraph()
+		for i := 1; i < len(vertices); i++ {
+			g.Insert(vertices[i-1], vertices[i])
+		}
+
+		order, err := g.TopologicalSort()
+		if err != nil || !slices.Equal(order, vertices) {
+			t.Fatalf("A path should be its own topological order; got %v", err)
+		}
+
+		// Closing the path makes it a single component.
+		g.Insert(vertices[len(vertices)-1], vertices[0])
+		if n, m := len(g.Tarjan()), len(g.Kosaraju()); n != 1 || m != 1 {
+			t.Fatalf("A cycle should be one component; got %d and %d", n, m)
+		}
+	}
+
+	rapid.Check(t, f)
+}
@@ -0,0 +1,78 @@
+package graphs
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+
+	"github.com/abucarlo/hackerrank/interviews/export"
+)
+
+func exportID(v int32) string {
+	return strconv.Itoa(int(v))
+}
+
+// exportAdjacency lists the vertices and edges of an undirected graph in
+// ascending order, with the given clusters.
+func exportAdjacency(name string, adjacency map[int32]*Set[int32], clusters [][]int32, attributes func(v int32) []export.Attribute) export.Graph {
+	g := export.Graph{Name: name}
+
+	vertices := make([]int32, 0, len(adjacency))
+	for v := range adjacency {
+		vertices = append(vertices, v)
+	}
+	slices.Sort(vertices)
+	for _, u := range vertices {
+		g.Nodes = append(g.Nodes, export.Node{ID: exportID(u), Attributes: attributes(u)})
+		neighbors := adjacency[u].Items()
+		slices.Sort(neighbors)
+		for _, v := range neighbors {
+			if u < v {
+				g.Edges = append(g.Edges, export.Edge{From: exportID(u), To: exportID(v)})
+			}
+		}
+	}
+
+	for _, cluster := range clusters {
+		slices.Sort(cluster)
+	}
+	slices.SortFunc(clusters, func(a, b []int32) int { return cmp.Compare(a[0], b[0]) })
+	for _, cluster := range clusters {
+		ids := make([]string, len(cluster))
+		for i, v := range cluster {
+			ids[i] = exportID(v)
+		}
+		g.Clusters = append(g.Clusters, ids)
+	}
+	return g
+}
+
+// Export returns the graph for writing as DOT or GraphML, with every
+// spanning tree found by FindDisconnected as a cluster.
+func (g *UndirectedGraph) Export(name string) export.Graph {
+	var clusters [][]int32
+	for _, tree := range g.FindDisconnected() {
+		cluster := make([]int32, 0, len(tree.adjacency))
+		for v := range tree.adjacency {
+			cluster = append(cluster, v)
+		}
+		clusters = append(clusters, cluster)
+	}
+	return exportAdjacency(name, g.adjacency, clusters, func(int32) []export.Attribute { return nil })
+}
+
+// Export returns the graph for writing as DOT or GraphML, with every
+// component found by FindDisconnected as a cluster. The color of every
+// vertex is its "color_id" attribute, since Graphviz has its own "color".
+func (g *ColoredGraph) Export(name string) export.Graph {
+	var clusters [][]int32
+	for _, component := range g.FindDisconnected() {
+		clusters = append(clusters, component.Items())
+	}
+	return exportAdjacency(name, g.adjacency, clusters, func(v int32) []export.Attribute {
+		if int(v) >= len(g.colors) {
+			return nil
+		}
+		return []export.Attribute{{Key: "color_id", Value: g.colors[v]}}
+	})
+}
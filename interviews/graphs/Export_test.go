@@ -0,0 +1,89 @@
+package graphs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abucarlo/hackerrank/interviews/export"
+	"pgregory.net/rapid"
+)
+
+func TestExportColoredGraph(t *testing.T) {
+	g := ConstructTestCase([]int32{1, 1, 4}, []int32{2, 3, 5}, []int64{1, 2, 1, 3, 3})
+
+	var out strings.Builder
+	if err := export.WriteDOT(&out, g.Export("clones")); err != nil {
+		t.Fatal(err)
+	}
+	expected := `graph "clones" {
+	subgraph cluster_0 {
+		1 [color_id=1];
+		2 [color_id=2];
+		3 [color_id=1];
+	}
+	subgraph cluster_1 {
+		4 [color_id=3];
+		5 [color_id=3];
+	}
+	1 -- 2;
+	1 -- 3;
+	4 -- 5;
+}
+`
+	if out.String() != expected {
+		t.Errorf("Expected\n%s\ngot\n%s", expected, out.String())
+	}
+}
+
+func TestExportUndirectedGraph(t *testing.T) {
+	f := func(t *rapid.T) {
+		order := rapid.Int32Range(2, 30).Draw(t, "order")
+		vertex := rapid.Int32Range(1, order)
+		g := NewUndirectedGraph()
+		for range rapid.IntRange(1, 40).Draw(t, "size") {
+			u, v := vertex.Draw(t, "u"), vertex.Draw(t, "v")
+			if u != v {
+				g.Insert(u, v)
+			}
+		}
+
+		e := g.Export("g")
+		if len(e.Nodes) != int(g.Order()) || len(e.Edges) != int(g.Size()) {
+			t.Fatalf("Expected %d nodes and %d edges; got %d and %d", g.Order(), g.Size(), len(e.Nodes), len(e.Edges))
+		}
+		if len(e.Clusters) != len(g.FindDisconnected()) {
+			t.Fatalf("Expected a cluster for each of %d components; got %d", len(g.FindDisconnected()), len(e.Clusters))
+		}
+		cluster := make(map[string]int)
+		for i, ids := range e.Clusters {
+			for _, id := range ids {
+				if _, ok := cluster[id]; ok {
+					t.Fatalf("%s is in two clusters", id)
+				}
+				cluster[id] = i
+			}
+		}
+		if len(cluster) != len(e.Nodes) {
+			t.Fatalf("Expected every one of %d nodes in a cluster; got %d", len(e.Nodes), len(cluster))
+		}
+		for _, edge := range e.Edges {
+			if cluster[edge.From] != cluster[edge.To] {
+				t.Fatalf("Edge %v joins two clusters", edge)
+			}
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+// Clusters are ordered by their least vertices, however far apart.
+func TestExportClusterOrder(t *testing.T) {
+	g := NewUndirectedGraph()
+	g.Insert(2000000000, 2000000001)
+	g.Insert(-2000000000, -1999999999)
+
+	e := g.Export("g")
+	if len(e.Clusters) != 2 || e.Clusters[0][0] != "-2000000000" || e.Clusters[1][0] != "2000000000" {
+		t.Errorf("Expected the cluster of -2000000000 first; got %v", e.Clusters)
+	}
+}
@@ -0,0 +1,288 @@
+package graphs
+
+/*
+	https://www.hackerrank.com/challenges/find-the-nearest-clone/problem
+
+	"n this challenge, there is a connected undirected graph where each of the nodes is a color.
+	Given a color, find the shortest path connecting any two nodes of that color. Each edge has a weight of 1."
+*/
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/abucarlo/hackerrank/interviews/input"
+)
+
+// ColoredGraph is an undirected graph whose vertices are each assigned a color.
+type ColoredGraph struct {
+	adjacency map[int32]*Set[int32]
+	// This seems insane.
+	colors []int32
+}
+
+type _DisjointSets map[int32]*Set[int32]
+
+func NewColoredGraph() *ColoredGraph {
+	g := ColoredGraph{make(map[int32]*Set[int32]), make([]int32, 1 << 10)}
+	return &g
+}
+
+func (g *ColoredGraph) Order() int32 {
+	return int32(len(g.adjacency))
+}
+
+func (g *ColoredGraph) AddEdge(u, v int32) {
+	if _, ok := g.adjacency[u]; !ok {
+		g.adjacency[u] = NewSet[int32]()
+	}
+	g.adjacency[u].Add(v)
+	if _, ok := g.adjacency[v]; !ok {
+		g.adjacency[v] = NewSet[int32]()
+	}
+	g.adjacency[v].Add(u)
+}
+
+func (g *ColoredGraph) SetColor(v int32, color int32) {
+	if int32(len(g.colors)) < v+1 {
+		l := len(g.colors)
+		for l < int(v + 1) {
+			l *= 2
+		}
+		tmp := make([]int32, l)
+		copy(tmp, g.colors)
+		g.colors = tmp
+	}
+	g.colors[int(v)] = color
+}
+
+// FindDisconnected returns the vertices of every connected component.
+func (g *ColoredGraph) FindDisconnected() _DisjointSets {
+	uf := NewUnionFind[int32]()
+
+	for u, s := range g.adjacency {
+		uf.Add(u)
+		for _, v := range s.Items() {
+			uf.Union(u, v)
+		}
+	}
+
+	disjoints := make(_DisjointSets)
+	for root, component := range uf.Components() {
+		disjoints[root] = NewSet[int32]()
+		for _, v := range component {
+			disjoints[root].Add(v)
+		}
+	}
+	return disjoints
+}
+
+// SolveSubgraph returns the length of the shortest path between two
+// vertices of the given color, or -1 if there is none.
+func (g *ColoredGraph) SolveSubgraph(color int32) int32 {
+	countColored := 0
+	for u := range g.adjacency {
+		if g.colors[u] == color {
+			countColored++
+		}
+	}
+	if countColored < 2 {
+		return -1
+	}
+
+	closestClone := int32(math.MaxInt32)
+	// Test how many colored nodes there are.
+	// https://en.wikipedia.org/wiki/Dijkstra%27s_algorithm#Pseudocode
+	for source := range g.adjacency {
+		if g.colors[source] != color {
+			continue
+		}
+
+		visited := NewSet[int32]()
+		q := []int32{source}
+
+		distances := make(map[int32]int)
+		distances[source] = 0
+
+		u := source
+
+		for {
+
+			visited.Add(u)
+
+			q = q[1:]
+
+			for _, v := range g.adjacency[u].Items() {
+				if visited.Has(v) {
+					continue
+				}
+				q = append(q, v)
+				alt := distances[u] + 1
+				if d, ok := distances[v]; ok {
+					if alt < d {
+						distances[v] = alt
+					}
+				} else {
+					distances[v] = alt
+				}
+			}
+
+			if len(q) == 0 {
+				break
+			}
+			u = q[0]
+		}
+
+		for target, distance := range distances {
+			if target == source {
+				continue
+			}
+			if g.colors[target] == color {
+				closestClone = min(closestClone, int32(distance))
+			}
+		}
+	}
+
+	if closestClone == math.MaxInt32 {
+		return -1
+	}
+	return closestClone
+}
+
+// SolveDijkstra solves SolveSubgraph on every connected component separately.
+func (g *ColoredGraph) SolveDijkstra(color int32) int32 {
+
+	disjoints := g.FindDisconnected()
+
+	solution := int32(math.MaxInt32)
+
+	for _, h := range disjoints {
+		if h.Size() < 2 {
+			continue
+		}
+		sub := ColoredGraph{make(map[int32]*Set[int32]), g.colors}
+		for _, u := range h.Items() {
+			sub.adjacency[u] = g.adjacency[u]
+		}
+
+		// A component with fewer than two vertices of the color has no answer.
+		if d := sub.SolveSubgraph(color); d != -1 {
+			solution = min(solution, d)
+		}
+	}
+
+	if solution == math.MaxInt32 {
+		return -1
+	}
+	return solution
+}
+
+// NearestClone returns the length of the shortest path between two
+// vertices of the given color, or -1 if there is none.
+//
+// Rather than searching from every vertex of the color in turn, it searches
+// from all of them at once, remembering which one reached every vertex first.
+// An edge between two vertices reached from different sources closes a path
+// between those sources, and the shortest such path is the answer.
+// See https://en.wikipedia.org/wiki/Breadth-first_search
+func (g *ColoredGraph) NearestClone(color int32) int32 {
+	type reached struct {
+		source, distance int32
+	}
+	visited := make(map[int32]reached)
+	var q []int32
+	for u := range g.adjacency {
+		if int(u) < len(g.colors) && g.colors[u] == color {
+			visited[u] = reached{u, 0}
+			q = append(q, u)
+		}
+	}
+
+	closestClone := int32(math.MaxInt32)
+	for len(q) > 0 {
+		u := q[0]
+		q = q[1:]
+		from := visited[u]
+		// Every vertex further along is at least this far from its
+		// source, so no later path can be shorter.
+		if 2*from.distance+1 >= closestClone {
+			break
+		}
+		for v := range g.adjacency[u].m {
+			to, ok := visited[v]
+			if !ok {
+				visited[v] = reached{from.source, from.distance + 1}
+				q = append(q, v)
+			} else if to.source != from.source {
+				closestClone = min(closestClone, from.distance+to.distance+1)
+			}
+		}
+	}
+
+	if closestClone == math.MaxInt32 {
+		return -1
+	}
+	return closestClone
+}
+
+// ConstructTestCase builds a ColoredGraph from HackerRank's parallel arrays
+// of edge endpoints and the colors of the vertices 1 through n.
+func ConstructTestCase(from []int32, to []int32, colors []int64) *ColoredGraph {
+	g := NewColoredGraph()
+	for j, u := range from {
+		g.AddEdge(u, to[j])
+	}
+	for i, color := range colors {
+		g.SetColor(int32(i+1), int32(color))
+	}
+	return g
+}
+
+// FindShortest is the signature HackerRank expects.
+func FindShortest(_ int32, graphFrom []int32, graphTo []int32, ids []int64, val int32) int32 {
+	g := ConstructTestCase(graphFrom, graphTo, ids)
+	return g.NearestClone(val)
+}
+
+// ReadFindClone reads HackerRank's input: the number of nodes and edges,
+// the edges, the color of every node, and the color to solve for.
+func ReadFindClone(r io.Reader) (*ColoredGraph, int32, error) {
+	in := input.NewScanner(r)
+	order := in.Count(1000000)
+	size := in.Count(1000000)
+
+	g := NewColoredGraph()
+	for range size {
+		u, v := in.Int32(), in.Int32()
+		if in.Err() != nil {
+			return nil, 0, in.Err()
+		}
+		if u < 1 || u > int32(order) || v < 1 || v > int32(order) {
+			in.Errorf("edge (%d, %d) is not between nodes 1 and %d", u, v, order)
+			return nil, 0, in.Err()
+		}
+		g.AddEdge(u, v)
+	}
+
+	for v := int32(1); v <= int32(order); v++ {
+		g.SetColor(v, in.Int32())
+	}
+
+	color := in.Int32()
+	if in.Err() != nil {
+		return nil, 0, in.Err()
+	}
+	return g, color, nil
+}
+
+// SolveFindClone reads HackerRank's input and writes the length of the
+// shortest path between two nodes of the given color.
+func SolveFindClone(r io.Reader, w io.Writer) error {
+	g, color, err := ReadFindClone(r)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, g.NearestClone(color))
+	return err
+}
@@ -0,0 +1,84 @@
+package graphs
+
+/*
+	A spanning forest of an undirected graph in a single breadth-first
+	search: every vertex discovered from another adds the edge between
+	them to the forest, and belongs to the same component.
+
+	FindDisconnected finds the components with one union-find, then copies
+	every one into a new graph and runs another to find its spanning tree.
+	When only the component of every vertex, or only the number of
+	vertices in every component, is wanted, this is all that is needed.
+
+	See https://en.wikipedia.org/wiki/Spanning_tree#Spanning_forests
+*/
+
+import "slices"
+
+// Forest is a spanning forest of a graph. Components are numbered from 0,
+// in order of their least vertices.
+type Forest struct {
+	// Component maps every vertex to its component.
+	Component map[int32]int32
+	// Sizes counts the vertices of every component.
+	Sizes []int32
+	// Edges holds a spanning tree of every component, component by
+	// component, each edge from the vertex that discovered the other.
+	Edges [][2]int32
+}
+
+// SpanningForest returns the components of the graph and a spanning tree
+// of every one.
+func (g *UndirectedGraph) SpanningForest() Forest {
+	f := Forest{
+		Component: make(map[int32]int32, len(g.adjacency)),
+		Edges:     make([][2]int32, 0, len(g.adjacency)),
+	}
+	g.EachComponent(func(vertices []int32, edges [][2]int32) bool {
+		id := int32(len(f.Sizes))
+		for _, v := range vertices {
+			f.Component[v] = id
+		}
+		f.Sizes = append(f.Sizes, int32(len(vertices)))
+		f.Edges = append(f.Edges, edges...)
+		return true
+	})
+	return f
+}
+
+// EachComponent calls visit with the vertices of every component, in
+// order of their least vertices, and the edges of a spanning tree of it,
+// until visit returns false. The slices are reused from one call to the
+// next, so visit must copy whatever it keeps.
+func (g *UndirectedGraph) EachComponent(visit func(vertices []int32, edges [][2]int32) bool) {
+	roots := make([]int32, 0, len(g.adjacency))
+	for v := range g.adjacency {
+		roots = append(roots, v)
+	}
+	slices.Sort(roots)
+
+	visited := make(map[int32]bool, len(g.adjacency))
+	var vertices []int32
+	var edges [][2]int32
+	for _, root := range roots {
+		if visited[root] {
+			continue
+		}
+		visited[root] = true
+		vertices, edges = append(vertices[:0], root), edges[:0]
+		// The vertices found so far are also the queue.
+		for i := 0; i < len(vertices); i++ {
+			u := vertices[i]
+			for v := range g.adjacency[u].m {
+				if !visited[v] {
+					visited[v] = true
+					vertices = append(vertices, v)
+					edges = append(edges, [2]int32{u, v})
+				}
+			}
+		}
+		if !visit(vertices, edges) {
+			return
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package graphs
+
+import (
+	"testing"
+
+	"github.com/abucarlo/hackerrank/interviews/graphs/generate"
+	"pgregory.net/rapid"
+)
+
+func TestSpanningForest(t *testing.T) {
+	f := func(t *rapid.T) {
+		g := generate.Disjoint(generate.Any(50), 4).Draw(t, "graph")
+		graph := undirectedGraph(g)
+		forest := graph.SpanningForest()
+
+		// Isolated vertices are not in the graph.
+		isolated := g.Order - graph.Order()
+		if int32(len(forest.Sizes))+isolated != g.Components {
+			t.Fatalf("%v: found %d components and %d isolated vertices", g, len(forest.Sizes), isolated)
+		}
+		if int32(len(forest.Component)) != graph.Order() {
+			t.Fatalf("%v: expected a component for all %d vertices; got %d", g, graph.Order(), len(forest.Component))
+		}
+		counts := make([]int32, len(forest.Sizes))
+		least := make([]int32, len(forest.Sizes))
+		for v, c := range forest.Component {
+			if counts[c] == 0 || v < least[c] {
+				least[c] = v
+			}
+			counts[c]++
+		}
+		for c, size := range forest.Sizes {
+			if counts[c] != size {
+				t.Fatalf("%v: component %d has %d vertices, not %d", g, c, counts[c], size)
+			}
+			if c > 0 && least[c-1] > least[c] {
+				t.Fatalf("%v: component %d begins at %d, after %d", g, c, least[c], least[c-1])
+			}
+		}
+
+		if int32(len(forest.Edges)) != graph.Order()-int32(len(forest.Sizes)) {
+			t.Fatalf("%v: a spanning forest of %d components needs %d edges; got %d", g, len(forest.Sizes), graph.Order()-int32(len(forest.Sizes)), len(forest.Edges))
+		}
+		disjoints := NewUnionFind[int32]()
+		for _, e := range forest.Edges {
+			if !graph.adjacency[e[0]].Has(e[1]) {
+				t.Fatalf("%v: (%d, %d) is not an edge", g, e[0], e[1])
+			}
+			if !disjoints.Union(e[0], e[1]) {
+				t.Fatalf("%v: edge (%d, %d) closes a cycle", g, e[0], e[1])
+			}
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+func TestEachComponentStops(t *testing.T) {
+	graph := NewUndirectedGraph()
+	graph.Insert(1, 2)
+	graph.Insert(3, 4)
+	calls := 0
+	graph.EachComponent(func([]int32, [][2]int32) bool {
+		calls++
+		return false
+	})
+	if calls != 1 {
+		t.Errorf("Expected 1 call; got %d", calls)
+	}
+}
+
+// BenchmarkComponents compares FindDisconnected, which copies every
+// component into a new graph, to the one-pass spanning forest, on graphs
+// as large as TestStronglyConnected's and on many small components.
+func BenchmarkComponents(b *testing.B) {
+	complete := NewUndirectedGraph()
+	for u := int32(1); u <= 316; u++ {
+		for v := u + 1; v <= 316; v++ {
+			complete.Insert(u, v)
+		}
+	}
+	paths := NewUndirectedGraph()
+	for u := int32(1); u <= 50000; u++ {
+		if u%5 != 0 {
+			paths.Insert(u, u+1)
+		}
+	}
+
+	for name, g := range map[string]*UndirectedGraph{"Complete": complete, "Paths": paths} {
+		b.Run(name+"/FindDisconnected", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				g.FindDisconnected()
+			}
+		})
+		b.Run(name+"/SpanningForest", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				g.SpanningForest()
+			}
+		})
+		b.Run(name+"/EachComponent", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				g.EachComponent(func([]int32, [][2]int32) bool { return true })
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package graphs
+
+/*
+	A grid of filled and empty cells, and the regions of filled cells
+	connected to one another.
+
+	Cells of a square grid touch their 4 orthogonal neighbors, or their
+	8 neighbors counting diagonals. A hexagonal grid is laid out in rows,
+	with every odd row shifted right by half a cell, so that every cell
+	touches 6 neighbors.
+
+	A hole is a region of empty cells that does not reach the edge of the
+	grid. Empty cells connect the other way filled cells do: where filled
+	cells connect diagonally, empty cells do not, and the other way round,
+	so that a diagonal line of filled cells closes a hole exactly when it
+	separates two empty regions.
+
+	See https://en.wikipedia.org/wiki/Connected-component_labeling
+	and https://www.redblobgames.com/grids/hexagons/#coordinates-offset
+*/
+
+import "fmt"
+
+// Connectivity is the number of neighbors that every cell of a grid touches.
+type Connectivity int
+
+const (
+	FourConnected  Connectivity = 4
+	SixConnected   Connectivity = 6
+	EightConnected Connectivity = 8
+)
+
+// offsets returns the row and column offsets of the neighbors of a cell in
+// the given row.
+func (c Connectivity) offsets(row int) [][2]int {
+	switch c {
+	case FourConnected:
+		return [][2]int{{-1, 0}, {0, -1}, {0, 1}, {1, 0}}
+	case SixConnected:
+		if row%2 == 0 {
+			return [][2]int{{-1, -1}, {-1, 0}, {0, -1}, {0, 1}, {1, -1}, {1, 0}}
+		}
+		return [][2]int{{-1, 0}, {-1, 1}, {0, -1}, {0, 1}, {1, 0}, {1, 1}}
+	case EightConnected:
+		return [][2]int{{-1, -1}, {-1, 0}, {-1, 1}, {0, -1}, {0, 1}, {1, -1}, {1, 0}, {1, 1}}
+	}
+	panic(fmt.Sprintf("connectivity must be 4, 6 or 8, not %d", c))
+}
+
+// dual is the connectivity of the empty cells.
+func (c Connectivity) dual() Connectivity {
+	switch c {
+	case FourConnected:
+		return EightConnected
+	case EightConnected:
+		return FourConnected
+	}
+	return c
+}
+
+// sides is the connectivity of the cells that share a side with a cell.
+func (c Connectivity) sides() Connectivity {
+	if c == EightConnected {
+		return FourConnected
+	}
+	return c
+}
+
+// Grid is a rectangle of filled and empty cells.
+type Grid struct {
+	height, width int
+	filled        []bool
+	connectivity  Connectivity
+}
+
+// NewGrid makes a grid from HackerRank's rows of cells, in which every
+// cell that is not 0 is filled. It panics if the rows are not all the same
+// length.
+func NewGrid(cells [][]int32, connectivity Connectivity) *Grid {
+	connectivity.offsets(0)
+	g := Grid{height: len(cells), connectivity: connectivity}
+	if g.height > 0 {
+		g.width = len(cells[0])
+	}
+	g.filled = make([]bool, g.height*g.width)
+	for i, row := range cells {
+		if len(row) != g.width {
+			panic(fmt.Sprintf("row %d has %d cells, not %d", i, len(row), g.width))
+		}
+		for j, value := range row {
+			g.filled[i*g.width+j] = value != 0
+		}
+	}
+	return &g
+}
+
+func (g *Grid) Height() int {
+	return g.height
+}
+
+func (g *Grid) Width() int {
+	return g.width
+}
+
+func (g *Grid) Filled(row, column int) bool {
+	return g.filled[row*g.width+column]
+}
+
+// neighbors calls f with every neighbor of the cell i inside the grid.
+func (g *Grid) neighbors(i int, connectivity Connectivity, f func(int)) {
+	row, column := i/g.width, i%g.width
+	for _, o := range connectivity.offsets(row) {
+		r, c := row+o[0], column+o[1]
+		if r >= 0 && r < g.height && c >= 0 && c < g.width {
+			f(r*g.width + c)
+		}
+	}
+}
+
+// label numbers the regions of cells that are filled, or empty, from 1,
+// with a breadth-first search from every cell not yet labeled. It calls
+// visit with every cell of every region, in the order they are found.
+func (g *Grid) label(filled bool, connectivity Connectivity, visit func(label int32, i int)) []int32 {
+	labels := make([]int32, len(g.filled))
+	var count int32
+	q := make([]int, 0, len(g.filled))
+	for root, f := range g.filled {
+		if f != filled || labels[root] != 0 {
+			continue
+		}
+		count++
+		labels[root] = count
+		q = append(q[:0], root)
+		for len(q) > 0 {
+			i := q[0]
+			q = q[1:]
+			visit(count, i)
+			g.neighbors(i, connectivity, func(j int) {
+				if g.filled[j] == filled && labels[j] == 0 {
+					labels[j] = count
+					q = append(q, j)
+				}
+			})
+		}
+	}
+	return labels
+}
+
+// Region is a connected region of filled cells.
+type Region struct {
+	Size int32
+	// The bounding box, from the top left cell to the bottom right one.
+	Top, Left, Bottom, Right int
+	// Perimeter counts the sides of the cells of the region that face an
+	// empty cell or the edge of the grid. Squares have 4 sides, even when
+	// they connect diagonally, and hexagons 6.
+	Perimeter int32
+}
+
+// Labeling describes the regions of a grid.
+type Labeling struct {
+	// Labels[i][j] is 0 for an empty cell, and l for a cell of Regions[l - 1].
+	Labels  [][]int32
+	Regions []Region
+	// Holes counts the regions of empty cells that the filled cells enclose.
+	Holes int32
+}
+
+// Regions labels the regions of filled cells, numbered in the order of
+// their first cell by rows, and counts the holes.
+func (g *Grid) Regions() Labeling {
+	var l Labeling
+	flat := g.label(true, g.connectivity, func(label int32, i int) {
+		row, column := i/g.width, i%g.width
+		if int(label) > len(l.Regions) {
+			l.Regions = append(l.Regions, Region{Top: row, Left: column, Bottom: row, Right: column})
+		}
+		r := &l.Regions[label-1]
+		r.Size++
+		r.Top, r.Bottom = min(r.Top, row), max(r.Bottom, row)
+		r.Left, r.Right = min(r.Left, column), max(r.Right, column)
+
+		// Every side not shared with a filled cell faces out.
+		r.Perimeter += int32(g.connectivity.sides())
+		g.neighbors(i, g.connectivity.sides(), func(j int) {
+			if g.filled[j] {
+				r.Perimeter--
+			}
+		})
+	})
+
+	l.Labels = make([][]int32, g.height)
+	for row := range l.Labels {
+		l.Labels[row] = flat[row*g.width : (row+1)*g.width : (row+1)*g.width]
+	}
+
+	// Every empty region is a hole, unless it reaches the edge.
+	open := make(map[int32]bool)
+	g.label(false, g.connectivity.dual(), func(label int32, i int) {
+		if _, ok := open[label]; !ok {
+			open[label] = false
+		}
+		row, column := i/g.width, i%g.width
+		if row == 0 || row == g.height-1 || column == 0 || column == g.width-1 {
+			open[label] = true
+		}
+	})
+	for _, reachesEdge := range open {
+		if !reachesEdge {
+			l.Holes++
+		}
+	}
+	return l
+}
@@ -0,0 +1,168 @@
+package graphs
+
+import (
+	"slices"
+	"testing"
+
+	"pgregory.net/rapid"
+)
+
+func gridCells(t *rapid.T) [][]int32 {
+	height := rapid.IntRange(0, 12).Draw(t, "height")
+	width := rapid.IntRange(0, 12).Draw(t, "width")
+	cells := make([][]int32, height)
+	for i := range cells {
+		cells[i] = rapid.SliceOfN(rapid.Int32Range(0, 1), width, width).Draw(t, "row")
+	}
+	return cells
+}
+
+// eulerNumber returns the number of regions less the number of holes of a
+// square grid, by counting its 2 by 2 blocks of cells.
+// See https://en.wikipedia.org/wiki/Euler_characteristic#Bit_quads
+func eulerNumber(cells [][]int32, connectivity Connectivity) int {
+	filled := func(i, j int) bool {
+		return i >= 0 && i < len(cells) && j >= 0 && j < len(cells[i]) && cells[i][j] != 0
+	}
+	var one, three, diagonal int
+	for i := -1; i < len(cells); i++ {
+		for j := -1; len(cells) > 0 && j < len(cells[0]); j++ {
+			quad := []bool{filled(i, j), filled(i, j+1), filled(i+1, j), filled(i+1, j+1)}
+			switch n := len(slices.DeleteFunc(slices.Clone(quad), func(f bool) bool { return !f })); {
+			case n == 1:
+				one++
+			case n == 3:
+				three++
+			case n == 2 && quad[0] == quad[3]:
+				diagonal++
+			}
+		}
+	}
+	if connectivity == FourConnected {
+		return (one - three + 2*diagonal) / 4
+	}
+	return (one - three - 2*diagonal) / 4
+}
+
+func TestGridRegions(t *testing.T) {
+	f := func(t *rapid.T) {
+		cells := gridCells(t)
+		connectivity := rapid.SampledFrom([]Connectivity{FourConnected, SixConnected, EightConnected}).Draw(t, "connectivity")
+		l := NewGrid(cells, connectivity).Regions()
+
+		type cell struct{ row, column int }
+		disjoints := NewUnionFind[cell]()
+		for i, row := range cells {
+			for j, value := range row {
+				if value == 0 {
+					if l.Labels[i][j] != 0 {
+						t.Fatalf("Empty cell (%d, %d) is labeled %d", i, j, l.Labels[i][j])
+					}
+					continue
+				}
+				disjoints.Add(cell{i, j})
+				for _, o := range connectivity.offsets(i) {
+					r, c := i+o[0], j+o[1]
+					if r >= 0 && r < len(cells) && c >= 0 && c < len(row) && cells[r][c] != 0 {
+						disjoints.Union(cell{i, j}, cell{r, c})
+					}
+				}
+			}
+		}
+
+		// Regions are numbered in the order of their first cells, and
+		// two cells share a label exactly when they are connected.
+		regions := make([]Region, 0, len(l.Regions))
+		first := make(map[cell]int32)
+		for i, row := range cells {
+			for j, value := range row {
+				if value == 0 {
+					continue
+				}
+				root := disjoints.Find(cell{i, j})
+				label, ok := first[root]
+				if !ok {
+					label = int32(len(first) + 1)
+					first[root] = label
+					regions = append(regions, Region{Top: i, Left: j, Bottom: i, Right: j})
+				}
+				if l.Labels[i][j] != label {
+					t.Fatalf("Cell (%d, %d) should be labeled %d; got %d", i, j, label, l.Labels[i][j])
+				}
+				r := &regions[label-1]
+				r.Size++
+				r.Top, r.Bottom = min(r.Top, i), max(r.Bottom, i)
+				r.Left, r.Right = min(r.Left, j), max(r.Right, j)
+				for _, o := range connectivity.sides().offsets(i) {
+					if y, x := i+o[0], j+o[1]; y < 0 || y >= len(cells) || x < 0 || x >= len(row) || cells[y][x] == 0 {
+						r.Perimeter++
+					}
+				}
+			}
+		}
+		if !slices.Equal(l.Regions, regions) {
+			t.Fatalf("Expected regions %v; got %v", regions, l.Regions)
+		}
+
+		if connectivity != SixConnected {
+			if euler := eulerNumber(cells, connectivity); len(l.Regions)-int(l.Holes) != euler {
+				t.Fatalf("%d regions and %d holes should have an Euler number of %d", len(l.Regions), l.Holes, euler)
+			}
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+func TestGridHoles(t *testing.T) {
+	testCases := []struct {
+		name         string
+		cells        [][]int32
+		connectivity Connectivity
+		regions      []Region
+		holes        int32
+	}{
+		{
+			"Diagonal ring",
+			[][]int32{{0, 1, 0}, {1, 0, 1}, {0, 1, 0}},
+			EightConnected,
+			[]Region{{Size: 4, Top: 0, Left: 0, Bottom: 2, Right: 2, Perimeter: 16}},
+			1,
+		},
+		{
+			"Diagonal ring of 4-connected cells",
+			[][]int32{{0, 1, 0}, {1, 0, 1}, {0, 1, 0}},
+			FourConnected,
+			[]Region{
+				{Size: 1, Top: 0, Left: 1, Bottom: 0, Right: 1, Perimeter: 4},
+				{Size: 1, Top: 1, Left: 0, Bottom: 1, Right: 0, Perimeter: 4},
+				{Size: 1, Top: 1, Left: 2, Bottom: 1, Right: 2, Perimeter: 4},
+				{Size: 1, Top: 2, Left: 1, Bottom: 2, Right: 1, Perimeter: 4},
+			},
+			0,
+		},
+		{
+			// The six neighbors of the cell (2, 2), in an even row.
+			"Hexagonal ring",
+			[][]int32{
+				{0, 0, 0, 0, 0},
+				{0, 1, 1, 0, 0},
+				{0, 1, 0, 1, 0},
+				{0, 1, 1, 0, 0},
+				{0, 0, 0, 0, 0},
+			},
+			SixConnected,
+			[]Region{{Size: 6, Top: 1, Left: 1, Bottom: 3, Right: 3, Perimeter: 24}},
+			1,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.name, func(t *testing.T) {
+			l := NewGrid(test.cells, test.connectivity).Regions()
+			if !slices.Equal(l.Regions, test.regions) || l.Holes != test.holes {
+				t.Errorf("Expected %v and %d holes; got %v and %d", test.regions, test.holes, l.Regions, l.Holes)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package graphs
+
+/*
+	Maximum matching in a bipartite graph by Hopcroft and Karp: every phase
+	finds the shortest augmenting paths with a breadth-first search from
+	the free left vertices, then augments along as many disjoint ones as a
+	depth-first search can find, so only O(√V) phases are needed.
+
+	By Kőnig's theorem, a minimum vertex cover is as large as a maximum
+	matching, and the alternating paths from the free left vertices give one.
+
+	See https://en.wikipedia.org/wiki/Hopcroft%E2%80%93Karp_algorithm
+	and https://en.wikipedia.org/wiki/K%C5%91nig%27s_theorem_(graph_theory)
+*/
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Unmatched is the mate of a vertex that no edge of a matching covers.
+const Unmatched = -1
+
+// BipartiteGraph has edges from the left vertices 0 through l - 1 to the
+// right vertices 0 through r - 1.
+type BipartiteGraph struct {
+	right int32
+	// The right neighbors of every left vertex.
+	adjacency [][]int32
+}
+
+func NewBipartiteGraph(left, right int32) *BipartiteGraph {
+	return &BipartiteGraph{right, make([][]int32, left)}
+}
+
+func (g *BipartiteGraph) Left() int32 {
+	return int32(len(g.adjacency))
+}
+
+func (g *BipartiteGraph) Right() int32 {
+	return g.right
+}
+
+// AddEdge adds an edge from the left vertex u to the right vertex v. It
+// panics if either is not a vertex.
+func (g *BipartiteGraph) AddEdge(u, v int32) {
+	if u < 0 || u >= g.Left() || v < 0 || v >= g.right {
+		panic(fmt.Sprintf("edge (%d, %d) is not between a left and a right vertex", u, v))
+	}
+	g.adjacency[u] = append(g.adjacency[u], v)
+}
+
+// Matching pairs left and right vertices along edges of the graph.
+type Matching struct {
+	Size int32
+	// The mate of every left and every right vertex, or Unmatched.
+	Left, Right []int32
+}
+
+// VertexCover is a set of vertices that touches every edge of the graph.
+type VertexCover struct {
+	Left, Right []int32
+}
+
+// MaximumMatching returns a maximum matching, and a minimum vertex cover of
+// the same size.
+func (g *BipartiteGraph) MaximumMatching() (Matching, VertexCover) {
+	m := Matching{Left: make([]int32, g.Left()), Right: make([]int32, g.right)}
+	for u := range m.Left {
+		m.Left[u] = Unmatched
+	}
+	for v := range m.Right {
+		m.Right[v] = Unmatched
+	}
+
+	layer := make([]int32, g.Left())
+	next := make([]int, g.Left())
+	for {
+		last, ok := g.layers(m, layer)
+		if !ok {
+			break
+		}
+		clear(next)
+		for u := range g.Left() {
+			if m.Left[u] == Unmatched && g.augment(u, last, m, layer, next) {
+				m.Size++
+			}
+		}
+	}
+	return m, g.cover(m)
+}
+
+// layers numbers the left vertices by the length of the shortest
+// alternating path to them from a free left vertex, or -1, and returns the
+// layer of the left vertices next to the nearest free right vertices, if
+// any is reachable at all.
+func (g *BipartiteGraph) layers(m Matching, layer []int32) (int32, bool) {
+	frontier := NewSet[int32]()
+	for u := range layer {
+		layer[u] = -1
+		if m.Left[u] == Unmatched {
+			layer[u] = 0
+			frontier.Add(int32(u))
+		}
+	}
+
+	for depth := int32(0); !frontier.Empty(); depth++ {
+		found := false
+		next := NewSet[int32]()
+		for _, u := range frontier.Items() {
+			for _, v := range g.adjacency[u] {
+				if w := m.Right[v]; w == Unmatched {
+					found = true
+				} else if layer[w] < 0 {
+					layer[w] = depth + 1
+					next.Add(w)
+				}
+			}
+		}
+		if found {
+			return depth, true
+		}
+		frontier = next
+	}
+	return 0, false
+}
+
+// augment looks for a shortest augmenting path from u down the layers, and
+// flips the edges along it. next[u] skips the edges of u already tried.
+func (g *BipartiteGraph) augment(u, last int32, m Matching, layer []int32, next []int) bool {
+	for ; next[u] < len(g.adjacency[u]); next[u]++ {
+		v := g.adjacency[u][next[u]]
+		w := m.Right[v]
+		if (w == Unmatched && layer[u] == last) ||
+			(w != Unmatched && layer[w] == layer[u]+1 && g.augment(w, last, m, layer, next)) {
+			m.Left[u], m.Right[v] = v, u
+			return true
+		}
+	}
+	return false
+}
+
+// cover finds the vertices reachable from the free left vertices by
+// alternating paths. The left vertices not reached and the right vertices
+// reached cover every edge, one vertex per edge of the matching.
+func (g *BipartiteGraph) cover(m Matching) VertexCover {
+	reached := make([]bool, g.Left())
+	frontier := NewSet[int32]()
+	for u, v := range m.Left {
+		if v == Unmatched {
+			reached[u] = true
+			frontier.Add(int32(u))
+		}
+	}
+	right := NewSet[int32]()
+	for !frontier.Empty() {
+		next := NewSet[int32]()
+		for _, u := range frontier.Items() {
+			for _, v := range g.adjacency[u] {
+				right.Add(v)
+				if w := m.Right[v]; w != Unmatched && !reached[w] {
+					reached[w] = true
+					next.Add(w)
+				}
+			}
+		}
+		frontier = next
+	}
+
+	var c VertexCover
+	for u, ok := range reached {
+		if !ok {
+			c.Left = append(c.Left, int32(u))
+		}
+	}
+	c.Right = right.Items()
+	slices.Sort(c.Right)
+	return c
+}
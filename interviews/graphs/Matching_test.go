@@ -0,0 +1,145 @@
+package graphs
+
+import (
+	"math/bits"
+	"testing"
+
+	"pgregory.net/rapid"
+)
+
+// maximumMatching returns the size of a maximum matching by trying every
+// subset of the edges.
+func maximumMatching(edges [][2]int32) int {
+	best := 0
+	for subset := 0; subset < 1<<len(edges); subset++ {
+		if bits.OnesCount(uint(subset)) <= best {
+			continue
+		}
+		left, right := NewSet[int32](), NewSet[int32]()
+		matching := true
+		for i, e := range edges {
+			if subset&(1<<i) == 0 {
+				continue
+			}
+			if left.Has(e[0]) || right.Has(e[1]) {
+				matching = false
+				break
+			}
+			left.Add(e[0])
+			right.Add(e[1])
+		}
+		if matching {
+			best = bits.OnesCount(uint(subset))
+		}
+	}
+	return best
+}
+
+// minimumVertexCover returns the size of a minimum vertex cover by trying
+// every subset of the vertices. Bit u is the left vertex u, and bit
+// left + v the right vertex v.
+func minimumVertexCover(left, right int32, edges [][2]int32) int {
+	best := int(left + right)
+	for subset := 0; subset < 1<<(left+right); subset++ {
+		covered := true
+		for _, e := range edges {
+			if subset&(1<<e[0]) == 0 && subset&(1<<(left+e[1])) == 0 {
+				covered = false
+				break
+			}
+		}
+		if covered {
+			best = min(best, bits.OnesCount(uint(subset)))
+		}
+	}
+	return best
+}
+
+func TestMaximumMatching(t *testing.T) {
+	f := func(t *rapid.T) {
+		left := rapid.Int32Range(1, 6).Draw(t, "left")
+		right := rapid.Int32Range(1, 6).Draw(t, "right")
+		g := NewBipartiteGraph(left, right)
+		var edges [][2]int32
+		for range rapid.IntRange(0, 14).Draw(t, "size") {
+			u := rapid.Int32Range(0, left-1).Draw(t, "u")
+			v := rapid.Int32Range(0, right-1).Draw(t, "v")
+			g.AddEdge(u, v)
+			edges = append(edges, [2]int32{u, v})
+		}
+		m, c := g.MaximumMatching()
+
+		var size int32
+		for u, v := range m.Left {
+			if v == Unmatched {
+				continue
+			}
+			size++
+			if m.Right[v] != int32(u) {
+				t.Fatalf("Left vertex %d is matched to %d, but %d is matched to %d", u, v, v, m.Right[v])
+			}
+			if !g.hasEdge(int32(u), v) {
+				t.Fatalf("Left vertex %d is matched to %d without an edge", u, v)
+			}
+		}
+		for v, u := range m.Right {
+			if u != Unmatched && m.Left[u] != int32(v) {
+				t.Fatalf("Right vertex %d is matched to %d, but %d is matched to %d", v, u, u, m.Left[u])
+			}
+		}
+		if size != m.Size {
+			t.Fatalf("The matching has %d edges, but its size is %d", size, m.Size)
+		}
+		if expected := maximumMatching(edges); int(m.Size) != expected {
+			t.Fatalf("Expected a matching of %d edges; got %d", expected, m.Size)
+		}
+
+		inLeft, inRight := NewSet[int32](), NewSet[int32]()
+		for _, u := range c.Left {
+			inLeft.Add(u)
+		}
+		for _, v := range c.Right {
+			inRight.Add(v)
+		}
+		for _, e := range edges {
+			if !inLeft.Has(e[0]) && !inRight.Has(e[1]) {
+				t.Fatalf("The cover %v misses the edge %v", c, e)
+			}
+		}
+		if len(c.Left)+len(c.Right) != int(m.Size) {
+			t.Fatalf("The cover %v should have %d vertices", c, m.Size)
+		}
+		if expected := minimumVertexCover(left, right, edges); expected != int(m.Size) {
+			t.Fatalf("Expected a cover of %d vertices; got %d", expected, m.Size)
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+func (g *BipartiteGraph) hasEdge(u, v int32) bool {
+	for _, w := range g.adjacency[u] {
+		if w == v {
+			return true
+		}
+	}
+	return false
+}
+
+// A perfect matching on a long path needs an augmenting path through all of
+// it: the first phase matches every left u to the right u + 1, which leaves
+// the left n - 1 and the right 0 free at the two ends.
+func TestMaximumMatchingPath(t *testing.T) {
+	const n = 100000
+	g := NewBipartiteGraph(n, n)
+	for u := range int32(n) {
+		if u+1 < n {
+			g.AddEdge(u, u+1)
+		}
+		g.AddEdge(u, u)
+	}
+	m, c := g.MaximumMatching()
+	if m.Size != n || len(c.Left)+len(c.Right) != n {
+		t.Errorf("Expected a perfect matching and a cover of %d vertices; got %d and %d", n, m.Size, len(c.Left)+len(c.Right))
+	}
+}
@@ -0,0 +1,101 @@
+package graphs
+
+/*
+	https://www.hackerrank.com/challenges/matrix/problem
+
+	The cities and roads form a tree, and some cities hold machines. Find the
+	minimum total time to destroy roads so that no two machines are connected.
+
+	This is Kruskal's algorithm in reverse: consider the roads from the most
+	to the least time-consuming to destroy, and keep every road that does not
+	join two components that each hold a machine. Every road that would have
+	joined them is the cheapest way to separate them, so it is destroyed.
+*/
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/abucarlo/hackerrank/interviews/input"
+)
+
+// MinTime returns the minimum time to separate the machines in a tree
+// of cities 0 through order - 1, and the roads that are destroyed.
+func MinTime(order int32, roads []Edge[int32], machines []int32) (int64, []Edge[int32]) {
+	disjoints := NewDenseUnionFind(order)
+	// This is only meaningful for the root of each component.
+	armed := make([]bool, order)
+	for _, m := range machines {
+		armed[m] = true
+	}
+
+	sorted := slices.Clone(roads)
+	slices.SortFunc(sorted, func(a, b Edge[int32]) int { return -cmp.Compare(a.Weight, b.Weight) })
+
+	var time int64
+	var destroyed []Edge[int32]
+	for _, road := range sorted {
+		x, y := disjoints.Find(road.U), disjoints.Find(road.V)
+		if armed[x] && armed[y] {
+			time += int64(road.Weight)
+			destroyed = append(destroyed, road)
+			continue
+		}
+		disjoints.Union(x, y)
+		armed[disjoints.Find(x)] = armed[x] || armed[y]
+	}
+	return time, destroyed
+}
+
+// MatrixProblem is the input of the Matrix problem.
+type MatrixProblem struct {
+	Order    int32
+	Roads    []Edge[int32]
+	Machines []int32
+}
+
+// ReadMatrix reads HackerRank's input: the number of cities and of machines,
+// the roads with the time to destroy each one, and the cities with machines.
+func ReadMatrix(r io.Reader) (MatrixProblem, error) {
+	in := input.NewScanner(r)
+	order := int32(in.Count(100000))
+	p := MatrixProblem{order, make([]Edge[int32], max(order-1, 0)), make([]int32, in.Count(int(order)))}
+	for i := range p.Roads {
+		u, v, w := in.Int32(), in.Int32(), in.Int32()
+		if in.Err() != nil {
+			return MatrixProblem{}, in.Err()
+		}
+		if u < 0 || u >= order || v < 0 || v >= order || u == v {
+			in.Errorf("road (%d, %d) does not join two of the cities 0 through %d", u, v, order-1)
+			return MatrixProblem{}, in.Err()
+		}
+		if w < 0 {
+			in.Errorf("road (%d, %d) takes a negative time %d to destroy", u, v, w)
+			return MatrixProblem{}, in.Err()
+		}
+		p.Roads[i] = Edge[int32]{u, v, w}
+	}
+	for i := range p.Machines {
+		p.Machines[i] = in.Int32()
+		if in.Err() == nil && (p.Machines[i] < 0 || p.Machines[i] >= order) {
+			in.Errorf("machine %d is not in one of the cities 0 through %d", p.Machines[i], order-1)
+		}
+	}
+	if in.Err() != nil {
+		return MatrixProblem{}, in.Err()
+	}
+	return p, nil
+}
+
+// SolveMatrix reads HackerRank's input and writes the minimum time.
+func SolveMatrix(r io.Reader, w io.Writer) error {
+	p, err := ReadMatrix(r)
+	if err != nil {
+		return err
+	}
+	time, _ := MinTime(p.Order, p.Roads, p.Machines)
+	_, err = fmt.Fprintln(w, time)
+	return err
+}
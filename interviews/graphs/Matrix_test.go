@@ -0,0 +1,87 @@
+package graphs
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/abucarlo/hackerrank/interviews/harness"
+	"pgregory.net/rapid"
+)
+
+func TestMatrixSample(t *testing.T) {
+	p, err := ReadMatrix(strings.NewReader("5 3\n2 1 8\n1 0 5\n2 4 5\n1 3 4\n2\n4\n0\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	time, destroyed := MinTime(p.Order, p.Roads, p.Machines)
+	expected := []Edge[int32]{{1, 0, 5}, {2, 4, 5}}
+	slices.SortFunc(destroyed, compareEdges[int32])
+	if time != 10 || !slices.Equal(destroyed, expected) {
+		t.Errorf("Expected to destroy %v in 10; got %v in %d", expected, destroyed, time)
+	}
+}
+
+func TestMatrixFiles(t *testing.T) {
+	harness.Run(t, "./matrix-inputs", SolveMatrix)
+}
+
+// separated reports whether no two machines are connected by the roads.
+func separated(order int32, roads []Edge[int32], machines []int32) bool {
+	disjoints := NewDenseUnionFind(order)
+	for _, road := range roads {
+		disjoints.Union(road.U, road.V)
+	}
+	armed := make(map[int32]bool)
+	for _, m := range machines {
+		root := disjoints.Find(m)
+		if armed[root] {
+			return false
+		}
+		armed[root] = true
+	}
+	return true
+}
+
+func TestMinTime(t *testing.T) {
+	f := func(t *rapid.T) {
+		order := rapid.Int32Range(2, 10).Draw(t, "order")
+		roads := make([]Edge[int32], order-1)
+		for v := int32(1); v < order; v++ {
+			roads[v-1] = Edge[int32]{rapid.Int32Range(0, v-1).Draw(t, "parent"), v, rapid.Int32Range(1, 10).Draw(t, "time")}
+		}
+		machines := rapid.SliceOfNDistinct(rapid.Int32Range(0, order-1), 2, int(order), rapid.ID[int32]).Draw(t, "machines")
+
+		time, destroyed := MinTime(order, roads, machines)
+		var total int64
+		for _, road := range destroyed {
+			total += int64(road.Weight)
+		}
+		kept := slices.DeleteFunc(slices.Clone(roads), func(road Edge[int32]) bool { return slices.Contains(destroyed, road) })
+		if total != time || !separated(order, kept, machines) {
+			t.Fatalf("Destroying %v in %d should separate %v", destroyed, time, machines)
+		}
+
+		// Try destroying every subset of the roads.
+		best := time
+		for subset := 0; subset < 1<<len(roads); subset++ {
+			var cost int64
+			var rest []Edge[int32]
+			for i, road := range roads {
+				if subset&(1<<i) != 0 {
+					cost += int64(road.Weight)
+				} else {
+					rest = append(rest, road)
+				}
+			}
+			if cost < best && separated(order, rest, machines) {
+				best = cost
+			}
+		}
+		if best != time {
+			t.Fatalf("Expected %d; got %d", best, time)
+		}
+	}
+
+	rapid.Check(t, f)
+}
@@ -0,0 +1,179 @@
+package graphs
+
+/*
+	Maximum flow by Dinic's algorithm, and the minimum cut that it proves
+	optimal: by the max-flow min-cut theorem, the vertices still reachable
+	from the source in the residual network are one side of a cut whose
+	capacity equals the flow.
+
+	See https://en.wikipedia.org/wiki/Dinic%27s_algorithm
+	and https://en.wikipedia.org/wiki/Max-flow_min-cut_theorem
+*/
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"slices"
+)
+
+// FlowNetwork is a directed graph on the vertices 0 through n - 1 with a
+// non-negative capacity on every edge.
+type FlowNetwork struct {
+	// Edges are stored in pairs: edge i runs from from[i] to to[i], and
+	// edge i^1 is its residual, running the other way.
+	from, to []int32
+	capacity []int64
+	flow     []int64
+	// The edges leaving every vertex, including residuals.
+	edges [][]int32
+}
+
+func NewFlowNetwork(order int32) *FlowNetwork {
+	return &FlowNetwork{edges: make([][]int32, order)}
+}
+
+func (n *FlowNetwork) Order() int32 {
+	return int32(len(n.edges))
+}
+
+func (n *FlowNetwork) addPair(u, v int32, forward, backward int64) {
+	if forward < 0 {
+		panic(fmt.Sprintf("edge (%d, %d) has a negative capacity %d", u, v, forward))
+	}
+	i := int32(len(n.to))
+	n.from = append(n.from, u, v)
+	n.to = append(n.to, v, u)
+	n.capacity = append(n.capacity, forward, backward)
+	n.flow = append(n.flow, 0, 0)
+	n.edges[u] = append(n.edges[u], i)
+	n.edges[v] = append(n.edges[v], i+1)
+}
+
+// AddEdge adds an edge from u to v. Parallel edges add their capacities.
+// It panics if the capacity is negative.
+func (n *FlowNetwork) AddEdge(u, v int32, capacity int64) {
+	n.addPair(u, v, capacity, 0)
+}
+
+// AddUndirectedEdge adds an edge that carries flow either way, up to its
+// capacity. It panics if the capacity is negative.
+func (n *FlowNetwork) AddUndirectedEdge(u, v int32, capacity int64) {
+	n.addPair(u, v, capacity, capacity)
+}
+
+func (n *FlowNetwork) residual(i int32) int64 {
+	return n.capacity[i] - n.flow[i]
+}
+
+// levels returns the length of the shortest residual path from s to every
+// vertex, or -1.
+func (n *FlowNetwork) levels(s int32) []int32 {
+	level := make([]int32, n.Order())
+	for v := range level {
+		level[v] = -1
+	}
+	level[s] = 0
+	q := []int32{s}
+	for len(q) > 0 {
+		u := q[0]
+		q = q[1:]
+		for _, i := range n.edges[u] {
+			if v := n.to[i]; level[v] < 0 && n.residual(i) > 0 {
+				level[v] = level[u] + 1
+				q = append(q, v)
+			}
+		}
+	}
+	return level
+}
+
+// augment pushes up to limit along residual paths from u to t that climb
+// one level at every edge, and returns how much it pushed. next[u] skips
+// the edges of u that are already saturated or lead nowhere.
+func (n *FlowNetwork) augment(u, t int32, limit int64, level []int32, next []int) int64 {
+	if u == t {
+		return limit
+	}
+	for ; next[u] < len(n.edges[u]); next[u]++ {
+		i := n.edges[u][next[u]]
+		v := n.to[i]
+		if level[v] != level[u]+1 || n.residual(i) == 0 {
+			continue
+		}
+		if pushed := n.augment(v, t, min(limit, n.residual(i)), level, next); pushed > 0 {
+			n.flow[i] += pushed
+			n.flow[i^1] -= pushed
+			return pushed
+		}
+	}
+	return 0
+}
+
+// MaxFlow returns the value of a maximum flow from s to t, which replaces
+// any flow already in the network.
+func (n *FlowNetwork) MaxFlow(s, t int32) int64 {
+	clear(n.flow)
+	if s == t {
+		return 0
+	}
+
+	var total int64
+	for {
+		level := n.levels(s)
+		if level[t] < 0 {
+			return total
+		}
+		next := make([]int, n.Order())
+		for {
+			pushed := n.augment(s, t, math.MaxInt64, level, next)
+			if pushed == 0 {
+				break
+			}
+			total += pushed
+		}
+	}
+}
+
+// Cut is a minimum cut: the vertices on the side of the source, and the
+// edges from that side to the other, whose capacities add up to Capacity.
+type Cut struct {
+	Capacity int64
+	Source   []int32
+	Edges    []Edge[int64]
+}
+
+// MinCut returns a minimum cut separating s from t, after finding a maximum
+// flow. Its capacity is the value of the flow.
+func (n *FlowNetwork) MinCut(s, t int32) Cut {
+	c := Cut{Capacity: n.MaxFlow(s, t)}
+	level := n.levels(s)
+	for v, l := range level {
+		if l >= 0 {
+			c.Source = append(c.Source, int32(v))
+		}
+	}
+	for i := range n.to {
+		u, v := n.from[i], n.to[i]
+		if level[u] >= 0 && level[v] < 0 && n.capacity[i] > 0 {
+			c.Edges = append(c.Edges, Edge[int64]{u, v, n.capacity[i]})
+		}
+	}
+	slices.SortFunc(c.Edges, compareEdges[int64])
+	return c
+}
+
+// ReadFlowNetwork reads a network in the format of the Matrix problem: the
+// number of vertices and of terminals, the undirected edges with their
+// capacities, and the terminals.
+func ReadFlowNetwork(r io.Reader) (*FlowNetwork, []int32, error) {
+	p, err := ReadMatrix(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	n := NewFlowNetwork(p.Order)
+	for _, road := range p.Roads {
+		n.AddUndirectedEdge(road.U, road.V, int64(road.Weight))
+	}
+	return n, p.Machines, nil
+}
@@ -0,0 +1,133 @@
+package graphs
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"pgregory.net/rapid"
+)
+
+func TestMaxFlowSample(t *testing.T) {
+	// https://en.wikipedia.org/wiki/Maximum_flow_problem#/media/File:Max_flow.svg
+	n := NewFlowNetwork(6)
+	for _, e := range []Edge[int64]{{0, 1, 3}, {0, 2, 3}, {1, 2, 2}, {1, 3, 3}, {2, 4, 2}, {3, 4, 4}, {3, 5, 2}, {4, 5, 3}} {
+		n.AddEdge(e.U, e.V, e.Weight)
+	}
+	c := n.MinCut(0, 5)
+	if c.Capacity != 5 {
+		t.Errorf("Expected a flow of 5; got %d", c.Capacity)
+	}
+	if n.MaxFlow(5, 0) != 0 {
+		t.Errorf("Expected no flow back to the source")
+	}
+}
+
+func TestNegativeCapacity(t *testing.T) {
+	for name, add := range map[string]func(*FlowNetwork){
+		"AddEdge":           func(n *FlowNetwork) { n.AddEdge(0, 1, -1) },
+		"AddUndirectedEdge": func(n *FlowNetwork) { n.AddUndirectedEdge(0, 1, -1) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Expected a panic on a negative capacity")
+				}
+			}()
+			add(NewFlowNetwork(2))
+		})
+	}
+}
+
+func TestReadFlowNetworkNegativeCapacity(t *testing.T) {
+	_, _, err := ReadFlowNetwork(strings.NewReader("2 2\n0 1 -5\n0\n1\n"))
+	if expected := "line 2, column 5: road (0, 1) takes a negative time -5 to destroy"; err == nil || err.Error() != expected {
+		t.Errorf("Expected %q; got %v", expected, err)
+	}
+}
+
+// capacityOf returns the total capacity of the edges leaving the vertices in side.
+func capacityOf(edges []Edge[int64], side func(int32) bool) int64 {
+	var total int64
+	for _, e := range edges {
+		if side(e.U) && !side(e.V) {
+			total += e.Weight
+		}
+	}
+	return total
+}
+
+func TestMaxFlowMinCut(t *testing.T) {
+	f := func(t *rapid.T) {
+		order := rapid.Int32Range(2, 8).Draw(t, "order")
+		vertex := rapid.Int32Range(0, order-1)
+		n := NewFlowNetwork(order)
+		var edges []Edge[int64]
+		for range rapid.IntRange(0, 20).Draw(t, "size") {
+			e := Edge[int64]{vertex.Draw(t, "u"), vertex.Draw(t, "v"), rapid.Int64Range(0, 10).Draw(t, "capacity")}
+			if rapid.Bool().Draw(t, "undirected") {
+				n.AddUndirectedEdge(e.U, e.V, e.Weight)
+				edges = append(edges, Edge[int64]{e.V, e.U, e.Weight})
+			} else {
+				n.AddEdge(e.U, e.V, e.Weight)
+			}
+			edges = append(edges, e)
+		}
+		s := vertex.Draw(t, "s")
+		sink := vertex.Filter(func(v int32) bool { return v != s }).Draw(t, "t")
+
+		c := n.MinCut(s, sink)
+		if !slices.Contains(c.Source, s) || slices.Contains(c.Source, sink) {
+			t.Fatalf("Cut %v should separate %d from %d", c.Source, s, sink)
+		}
+		inSource := func(v int32) bool { return slices.Contains(c.Source, v) }
+		if capacity := capacityOf(edges, inSource); capacity != c.Capacity {
+			t.Fatalf("The flow is %d, but the cut %v has capacity %d", c.Capacity, c.Source, capacity)
+		}
+		var total int64
+		for _, e := range c.Edges {
+			total += e.Weight
+		}
+		if total != c.Capacity {
+			t.Fatalf("The flow is %d, but the edges %v of the cut add up to %d", c.Capacity, c.Edges, total)
+		}
+
+		// No cut is smaller than the flow.
+		for subset := 0; subset < 1<<order; subset++ {
+			side := func(v int32) bool { return subset&(1<<v) != 0 }
+			if side(s) && !side(sink) && capacityOf(edges, side) < c.Capacity {
+				t.Fatalf("A cut of capacity %d is smaller than the flow %d", capacityOf(edges, side), c.Capacity)
+			}
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+func TestMaxFlowMatrix(t *testing.T) {
+	f, err := os.Open("./matrix-inputs/input08.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	n, machines, err := ReadFlowNetwork(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// In a tree, the only way to separate two machines is to destroy a road
+	// on the path between them, so the Matrix answer for two machines is
+	// the cheapest such road, which is also the minimum cut.
+	for i := 1; i < 10; i++ {
+		s, sink := machines[0], machines[i]
+		roads := make([]Edge[int32], 0, n.Order()-1)
+		for j := 0; j < len(n.to); j += 2 {
+			roads = append(roads, Edge[int32]{n.from[j], n.to[j], int32(n.capacity[j])})
+		}
+		time, _ := MinTime(n.Order(), roads, []int32{s, sink})
+		if c := n.MinCut(s, sink); c.Capacity != time || len(c.Edges) != 1 {
+			t.Errorf("Separating %d from %d should take %d by destroying one road; got %d by %v", s, sink, time, c.Capacity, c.Edges)
+		}
+	}
+}
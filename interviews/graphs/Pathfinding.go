@@ -0,0 +1,323 @@
+package graphs
+
+/*
+	Shortest paths between two cells of a grid whose filled cells are
+	obstacles.
+
+	A* explores cells in order of the cost so far plus a heuristic estimate
+	of the cost to go; the closer the estimate, the fewer cells it expands,
+	and as long as it never overestimates, the path is shortest.
+
+	Castle on the Grid moves like a rook: a move slides any distance in a
+	straight line. Searching over pairs of a cell and the direction that
+	reached it, continuing straight costs nothing and turning costs one
+	move, so a 0-1 breadth-first search with a deque finds the fewest moves.
+
+	See https://en.wikipedia.org/wiki/A*_search_algorithm
+	and https://en.wikipedia.org/wiki/0-1_BFS
+	and https://www.hackerrank.com/challenges/castle-on-the-grid/problem
+*/
+
+import (
+	"container/heap"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+
+	"github.com/abucarlo/hackerrank/interviews/input"
+)
+
+type Cell struct {
+	Row, Column int
+}
+
+// Heuristic estimates the cost of a path between two cells.
+type Heuristic func(from, to Cell) float64
+
+// Manhattan is exact on an empty 4-connected grid, and overestimates on an
+// 8-connected one.
+func Manhattan(from, to Cell) float64 {
+	return float64(abs(from.Row-to.Row) + abs(from.Column-to.Column))
+}
+
+// Chebyshev counts diagonal steps as 1, so it never overestimates.
+func Chebyshev(from, to Cell) float64 {
+	return float64(max(abs(from.Row-to.Row), abs(from.Column-to.Column)))
+}
+
+// Octile is exact on an empty 8-connected grid, where diagonal steps cost √2,
+// and never overestimates on a 4-connected one.
+func Octile(from, to Cell) float64 {
+	dy, dx := abs(from.Row-to.Row), abs(from.Column-to.Column)
+	return float64(max(dy, dx)-min(dy, dx)) + math.Sqrt2*float64(min(dy, dx))
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Path is a path between two cells, with its cost and the number of cells
+// expanded to find it.
+type Path struct {
+	Cells    []Cell
+	Cost     float64
+	Expanded int
+}
+
+func (g *Grid) index(c Cell) int {
+	return c.Row*g.width + c.Column
+}
+
+func (g *Grid) cell(i int) Cell {
+	return Cell{i / g.width, i % g.width}
+}
+
+// walk follows parents back from the cell i, and returns the cells in order.
+func (g *Grid) walk(parent []int, i int) []Cell {
+	var cells []Cell
+	for ; i >= 0; i = parent[i] {
+		cells = append(cells, g.cell(i))
+	}
+	slices.Reverse(cells)
+	return cells
+}
+
+// AStar returns a shortest path from start to goal through empty cells,
+// and whether there is one. Steps to the orthogonal neighbors of a cell
+// cost 1, and on an 8-connected grid diagonal steps cost √2. It panics on a
+// hexagonal grid.
+func (g *Grid) AStar(start, goal Cell, h Heuristic) (Path, bool) {
+	if g.connectivity == SixConnected {
+		panic("A* needs a square grid")
+	}
+	var p Path
+	if g.filled[g.index(start)] || g.filled[g.index(goal)] {
+		return p, false
+	}
+
+	cost := make([]float64, len(g.filled))
+	parent := make([]int, len(g.filled))
+	for i := range cost {
+		cost[i], parent[i] = math.Inf(1), -1
+	}
+	closed := make([]bool, len(g.filled))
+	s, t := g.index(start), g.index(goal)
+	cost[s] = 0
+	q := &cellHeap{{s, h(start, goal)}}
+
+	for q.Len() > 0 {
+		u := heap.Pop(q).(estimate).i
+		if closed[u] {
+			continue
+		}
+		closed[u] = true
+		p.Expanded++
+		if u == t {
+			p.Cells, p.Cost = g.walk(parent, t), cost[t]
+			return p, true
+		}
+		from := g.cell(u)
+		g.neighbors(u, g.connectivity, func(v int) {
+			if g.filled[v] || closed[v] {
+				return
+			}
+			to := g.cell(v)
+			step := 1.0
+			if to.Row != from.Row && to.Column != from.Column {
+				step = math.Sqrt2
+			}
+			if c := cost[u] + step; c < cost[v] {
+				cost[v], parent[v] = c, u
+				heap.Push(q, estimate{v, c + h(to, goal)})
+			}
+		})
+	}
+	return p, false
+}
+
+// estimate is the estimated cost of a path through the cell i.
+type estimate struct {
+	i    int
+	cost float64
+}
+
+type cellHeap []estimate
+
+func (h cellHeap) Len() int           { return len(h) }
+func (h cellHeap) Less(i, j int) bool { return h[i].cost < h[j].cost }
+func (h cellHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *cellHeap) Push(x any)        { *h = append(*h, x.(estimate)) }
+func (h *cellHeap) Pop() any {
+	old := *h
+	e := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return e
+}
+
+// Slides returns a path from start to goal through empty cells with the
+// fewest moves, where a move slides any distance towards one of the
+// neighbors of a cell, and whether there is one. The path lists the cell
+// where every move stops, and its cost is the number of moves.
+func (g *Grid) Slides(start, goal Cell) (Path, bool) {
+	var p Path
+	if g.filled[g.index(start)] || g.filled[g.index(goal)] {
+		return p, false
+	}
+
+	// A state is a cell and the direction of the move that reached it, an
+	// index into the cell's neighbor offsets. Offsets at the same index
+	// point the same way in every row, even on a hexagonal grid.
+	directions := int(g.connectivity)
+	moves := make([]int32, len(g.filled)*directions)
+	parent := make([]int, len(moves))
+	for i := range moves {
+		moves[i], parent[i] = math.MaxInt32, -1
+	}
+	done := make([]bool, len(moves))
+
+	s, t := g.index(start), g.index(goal)
+	if s == t {
+		p.Cells, p.Expanded = []Cell{start}, 1
+		return p, true
+	}
+	// States reached at no extra cost go on a stack in front of the queue,
+	// which together make the deque. The first move from the start costs 1
+	// whichever way it goes.
+	var front, deque []int
+	for d := range directions {
+		moves[s*directions+d] = 0
+		front = append(front, s*directions+d)
+	}
+
+	for len(front) > 0 || len(deque) > 0 {
+		var state int
+		if len(front) > 0 {
+			state, front = front[len(front)-1], front[:len(front)-1]
+		} else {
+			state, deque = deque[0], deque[1:]
+		}
+		if done[state] {
+			continue
+		}
+		done[state] = true
+		p.Expanded++
+		u, direction := state/directions, state%directions
+		if u == t {
+			p.Cost = float64(moves[state])
+			var states []int
+			for ; state >= 0; state = parent[state] {
+				states = append(states, state)
+			}
+			slices.Reverse(states)
+			// Every move stops where the next one turns, and at the goal.
+			p.Cells = []Cell{start}
+			for i := 1; i < len(states); i++ {
+				if i == len(states)-1 || states[i+1]%directions != states[i]%directions {
+					p.Cells = append(p.Cells, g.cell(states[i]/directions))
+				}
+			}
+			return p, true
+		}
+
+		row := u / g.width
+		for d, o := range g.connectivity.offsets(row) {
+			r, c := row+o[0], u%g.width+o[1]
+			if r < 0 || r >= g.height || c < 0 || c >= g.width || g.filled[r*g.width+c] {
+				continue
+			}
+			next := (r*g.width+c)*directions + d
+			step := int32(1)
+			if d == direction && u != s {
+				step = 0
+			}
+			if m := moves[state] + step; m < moves[next] {
+				moves[next], parent[next] = m, state
+				if step == 0 {
+					front = append(front, next)
+				} else {
+					deque = append(deque, next)
+				}
+			}
+		}
+	}
+	return p, false
+}
+
+// Castle is a Castle on the Grid query.
+type Castle struct {
+	Grid        *Grid
+	Start, Goal Cell
+}
+
+// ReadCastle reads HackerRank's input: the size of the grid, its rows of
+// '.' for an empty cell and 'X' for a blocked one, then the row and column
+// of the start and of the goal.
+func ReadCastle(r io.Reader) (Castle, error) {
+	in := input.NewScanner(r)
+	n := in.Count(100)
+	cells := make([][]int32, n)
+	for i := range cells {
+		row := in.Word()
+		if in.Err() == nil && len(row) != n {
+			in.Errorf("row %d has %d cells, not %d", i, len(row), n)
+		}
+		cells[i] = make([]int32, len(row))
+		for j, b := range []byte(row) {
+			switch b {
+			case 'X':
+				cells[i][j] = 1
+			case '.':
+			default:
+				if in.Err() == nil {
+					in.Errorf("cell (%d, %d) is %q, not '.' or 'X'", i, j, b)
+				}
+			}
+		}
+	}
+	if in.Err() != nil {
+		return Castle{}, in.Err()
+	}
+
+	var q Castle
+	q.Grid = NewGrid(cells, FourConnected)
+	coordinate := func(what string) int {
+		x := in.Int()
+		if in.Err() == nil && (x < 0 || x >= n) {
+			in.Errorf("the %s is %d, not between 0 and %d", what, x, n-1)
+		}
+		return x
+	}
+	q.Start = Cell{coordinate("start row"), coordinate("start column")}
+	q.Goal = Cell{coordinate("goal row"), coordinate("goal column")}
+	if in.Err() == nil && (q.Grid.Filled(q.Start.Row, q.Start.Column) || q.Grid.Filled(q.Goal.Row, q.Goal.Column)) {
+		in.Errorf("the start and the goal must be empty cells")
+	}
+	if in.Err() != nil {
+		return Castle{}, in.Err()
+	}
+	return q, nil
+}
+
+// MinimumMoves returns the fewest moves a castle needs from the start to
+// the goal, or -1 if it cannot get there.
+func MinimumMoves(q Castle) int32 {
+	p, ok := q.Grid.Slides(q.Start, q.Goal)
+	if !ok {
+		return -1
+	}
+	return int32(p.Cost)
+}
+
+// SolveCastle reads HackerRank's input and writes the fewest moves.
+func SolveCastle(r io.Reader, w io.Writer) error {
+	q, err := ReadCastle(r)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, MinimumMoves(q))
+	return err
+}
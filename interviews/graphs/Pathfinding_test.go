@@ -0,0 +1,218 @@
+package graphs
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"testing"
+
+	"pgregory.net/rapid"
+)
+
+// emptyCell draws a cell of the grid that is not filled, if there is one.
+func emptyCell(t *rapid.T, cells [][]int32, label string) (Cell, bool) {
+	var empty []Cell
+	for i, row := range cells {
+		for j, value := range row {
+			if value == 0 {
+				empty = append(empty, Cell{i, j})
+			}
+		}
+	}
+	if len(empty) == 0 {
+		return Cell{}, false
+	}
+	return rapid.SampledFrom(empty).Draw(t, label), true
+}
+
+// relax returns the cost of a shortest path from start to every cell by
+// relaxing every step until nothing changes.
+func relax(g *Grid, start Cell) []float64 {
+	cost := make([]float64, len(g.filled))
+	for i := range cost {
+		cost[i] = math.Inf(1)
+	}
+	cost[g.index(start)] = 0
+	for changed := true; changed; {
+		changed = false
+		for u := range cost {
+			if g.filled[u] {
+				continue
+			}
+			g.neighbors(u, g.connectivity, func(v int) {
+				if g.filled[v] {
+					return
+				}
+				if c := cost[u] + step(g.cell(u), g.cell(v)); c < cost[v]-1e-9 {
+					cost[v], changed = c, true
+				}
+			})
+		}
+	}
+	return cost
+}
+
+func step(from, to Cell) float64 {
+	if from.Row != to.Row && from.Column != to.Column {
+		return math.Sqrt2
+	}
+	return 1
+}
+
+func TestAStar(t *testing.T) {
+	f := func(t *rapid.T) {
+		cells := gridCells(t)
+		start, ok := emptyCell(t, cells, "start")
+		if !ok {
+			t.Skip("no empty cells")
+		}
+		goal, _ := emptyCell(t, cells, "goal")
+		connectivity := rapid.SampledFrom([]Connectivity{FourConnected, EightConnected}).Draw(t, "connectivity")
+		g := NewGrid(cells, connectivity)
+		expected := relax(g, start)[g.index(goal)]
+
+		heuristics := map[string]Heuristic{"Chebyshev": Chebyshev, "Octile": Octile}
+		if connectivity == FourConnected {
+			heuristics["Manhattan"] = Manhattan
+		}
+		for name, h := range heuristics {
+			p, ok := g.AStar(start, goal, h)
+			if !ok {
+				if !math.IsInf(expected, 1) {
+					t.Fatalf("%s: expected a path of cost %f", name, expected)
+				}
+				continue
+			}
+			if math.Abs(p.Cost-expected) > 1e-9 {
+				t.Fatalf("%s: expected a path of cost %f; got %f along %v", name, expected, p.Cost, p.Cells)
+			}
+			if p.Cells[0] != start || p.Cells[len(p.Cells)-1] != goal {
+				t.Fatalf("%s: path %v should run from %v to %v", name, p.Cells, start, goal)
+			}
+			var cost float64
+			for i := 1; i < len(p.Cells); i++ {
+				from, to := p.Cells[i-1], p.Cells[i]
+				if g.Filled(to.Row, to.Column) || !slices.Contains(neighborCells(g, from), to) {
+					t.Fatalf("%s: path %v cannot step from %v to %v", name, p.Cells, from, to)
+				}
+				cost += step(from, to)
+			}
+			if math.Abs(cost-p.Cost) > 1e-9 {
+				t.Fatalf("%s: path %v costs %f, not %f", name, p.Cells, cost, p.Cost)
+			}
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+func neighborCells(g *Grid, c Cell) []Cell {
+	var cells []Cell
+	g.neighbors(g.index(c), g.connectivity, func(j int) {
+		cells = append(cells, g.cell(j))
+	})
+	return cells
+}
+
+// A closer estimate expands fewer cells.
+func TestHeuristics(t *testing.T) {
+	cells := make([][]int32, 30)
+	for i := range cells {
+		cells[i] = make([]int32, 30)
+	}
+	// A wall with a gap at the bottom.
+	for i := range 25 {
+		cells[i][15] = 1
+	}
+	g := NewGrid(cells, EightConnected)
+	none := func(Cell, Cell) float64 { return 0 }
+
+	dijkstra, _ := g.AStar(Cell{0, 0}, Cell{0, 29}, none)
+	chebyshev, _ := g.AStar(Cell{0, 0}, Cell{0, 29}, Chebyshev)
+	octile, _ := g.AStar(Cell{0, 0}, Cell{0, 29}, Octile)
+	if dijkstra.Cost != chebyshev.Cost || chebyshev.Cost != octile.Cost {
+		t.Errorf("Expected paths of equal cost; got %f, %f and %f", dijkstra.Cost, chebyshev.Cost, octile.Cost)
+	}
+	if !(octile.Expanded <= chebyshev.Expanded && chebyshev.Expanded < dijkstra.Expanded) {
+		t.Errorf("Expected Octile to expand the fewest cells and no heuristic the most; got %d, %d and %d",
+			octile.Expanded, chebyshev.Expanded, dijkstra.Expanded)
+	}
+}
+
+// slides returns the fewest moves from start to every cell, moving one
+// slide at a time.
+func slides(g *Grid, start Cell) []int32 {
+	moves := make([]int32, len(g.filled))
+	for i := range moves {
+		moves[i] = -1
+	}
+	moves[g.index(start)] = 0
+	q := []Cell{start}
+	for len(q) > 0 {
+		u := q[0]
+		q = q[1:]
+		for d := range int(g.connectivity) {
+			for c := u; ; {
+				o := g.connectivity.offsets(c.Row)[d]
+				c = Cell{c.Row + o[0], c.Column + o[1]}
+				if c.Row < 0 || c.Row >= g.height || c.Column < 0 || c.Column >= g.width || g.Filled(c.Row, c.Column) {
+					break
+				}
+				if moves[g.index(c)] < 0 {
+					moves[g.index(c)] = moves[g.index(u)] + 1
+					q = append(q, c)
+				}
+			}
+		}
+	}
+	return moves
+}
+
+func TestSlides(t *testing.T) {
+	f := func(t *rapid.T) {
+		cells := gridCells(t)
+		start, ok := emptyCell(t, cells, "start")
+		if !ok {
+			t.Skip("no empty cells")
+		}
+		goal, _ := emptyCell(t, cells, "goal")
+		connectivity := rapid.SampledFrom([]Connectivity{FourConnected, SixConnected, EightConnected}).Draw(t, "connectivity")
+		g := NewGrid(cells, connectivity)
+		expected := slides(g, start)[g.index(goal)]
+
+		p, ok := g.Slides(start, goal)
+		if !ok {
+			if expected >= 0 {
+				t.Fatalf("Expected %d moves", expected)
+			}
+			return
+		}
+		if int32(p.Cost) != expected || len(p.Cells) != int(expected)+1 {
+			t.Fatalf("Expected %d moves; got %f along %v", expected, p.Cost, p.Cells)
+		}
+		if p.Cells[0] != start || p.Cells[len(p.Cells)-1] != goal {
+			t.Fatalf("Path %v should run from %v to %v", p.Cells, start, goal)
+		}
+		for i := 1; i < len(p.Cells); i++ {
+			if moves := slides(g, p.Cells[i-1])[g.index(p.Cells[i])]; moves != 1 {
+				t.Fatalf("Path %v cannot slide from %v to %v", p.Cells, p.Cells[i-1], p.Cells[i])
+			}
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+func TestCastleSample(t *testing.T) {
+	q, err := ReadCastle(strings.NewReader("3\n.X.\n.X.\n...\n0 0 0 2\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if moves := MinimumMoves(q); moves != 3 {
+		t.Errorf("Expected 3 moves; got %d", moves)
+	}
+	p, _ := q.Grid.Slides(q.Start, q.Goal)
+	if expected := []Cell{{0, 0}, {2, 0}, {2, 2}, {0, 2}}; !slices.Equal(p.Cells, expected) {
+		t.Errorf("Expected to stop at %v; got %v", expected, p.Cells)
+	}
+}
@@ -0,0 +1,358 @@
+package graphs
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/abucarlo/hackerrank/interviews/input"
+)
+
+/*
+	https://www.hackerrank.com/challenges/torque-and-development/problem
+
+	The problem is to find the minimal spanning tree of every disconnected
+	subgraph, then determine if it's cheaper to build a library on each
+	one, or connect them by roads and build a single library.
+
+	See https://en.wikipedia.org/wiki/Disjoint-set_data_structure
+*/
+
+// UndirectedGraph is a simple graph: no loops, and no multiple edges.
+// A vertex exists only once an edge has been inserted on it.
+type UndirectedGraph struct {
+	adjacency map[int32]*Set[int32]
+}
+
+func NewUndirectedGraph() *UndirectedGraph {
+	g := UndirectedGraph{make(map[int32]*Set[int32])}
+	return &g
+}
+
+// "size" vs. "order" cf. https://en.wikipedia.org/wiki/Graph_(discrete_mathematics)#Graph
+
+func (g *UndirectedGraph) Order() int32 {
+	return int32(len(g.adjacency))
+}
+
+func (g *UndirectedGraph) Size() int32 {
+	result := 0
+	for _, s := range g.adjacency {
+		result += s.Size()
+	}
+	// In an undirected graph, we double the edges.
+	result /= 2
+	return int32(result)
+}
+
+// components returns the vertices of every connected component.
+func (g *UndirectedGraph) components() [][]int32 {
+	disjoints := NewUnionFind[int32]()
+
+	for u, s := range g.adjacency {
+		disjoints.Add(u)
+		for _, v := range s.Items() {
+			disjoints.Union(u, v)
+		}
+	}
+
+	var components [][]int32
+	for _, component := range disjoints.Components() {
+		components = append(components, component)
+	}
+	return components
+}
+
+// FindDisconnected returns a spanning tree of every connected component.
+func (g *UndirectedGraph) FindDisconnected() []UndirectedGraph {
+	// See mainly https://en.wikipedia.org/wiki/Kruskal%27s_algorithm
+	// I could have skipped a lot of this, since this algorithm
+	// "finds a minimum spanning forest of an undirected" graph.
+	var trees []UndirectedGraph
+	for _, component := range g.components() {
+		// Construct a new subgraph
+		// in which every adjacency list
+		// has a size of 1 or 2.
+		mst := NewUndirectedGraph()
+		sdisjoints := NewUnionFind[int32]()
+		for _, u := range component {
+			for _, v := range g.adjacency[u].Items() {
+				if sdisjoints.Union(u, v) {
+					mst.Insert(u, v)
+				}
+			}
+		}
+
+		trees = append(trees, *mst)
+	}
+
+	return trees
+}
+
+func (g *UndirectedGraph) Insert(u, v int32) {
+	if u == v {
+		panic("u must not == v")
+	}
+
+	if _, ok := g.adjacency[v]; !ok {
+		g.adjacency[v] = NewSet[int32]()
+	}
+
+	if _, ok := g.adjacency[u]; !ok {
+		g.adjacency[u] = NewSet[int32]()
+	}
+
+	g.adjacency[u].Add(v)
+	g.adjacency[v].Add(u)
+}
+
+// RoadsAndLibraries returns the minimum cost of giving every one of the
+// cities 1 through order access to a library, given the cost of building
+// a library and of repairing a road.
+func RoadsAndLibraries(order int32, library int32, road int32, edges [][]int32) int64 {
+	return PlanRoadsAndLibraries(order, library, road, edges).Cost
+}
+
+// RoadsPlan says where to build libraries and which roads to repair.
+type RoadsPlan struct {
+	Cost int64
+	// Libraries lists the cities that get a library, in ascending order,
+	// including every city with no roads at all.
+	Libraries []int32
+	// Roads lists the roads to repair, each from a city that can already
+	// reach a library to one that cannot.
+	Roads [][2]int32
+}
+
+// PlanRoadsAndLibraries returns a cheapest plan for giving every one of the
+// cities 1 through order access to a library. When a road is cheaper than a
+// library, every group of connected cities gets one library, in its least
+// city, and the roads of a spanning tree; otherwise every city gets a library.
+func PlanRoadsAndLibraries(order int32, library int32, road int32, edges [][]int32) RoadsPlan {
+	var p RoadsPlan
+	if library <= road {
+		p.Libraries = make([]int32, order)
+		for i := range p.Libraries {
+			p.Libraries[i] = int32(i + 1)
+		}
+		p.Cost = int64(library) * int64(order)
+		return p
+	}
+
+	graph := NewUndirectedGraph()
+	for _, edge := range edges {
+		u, v := edge[0], edge[1]
+		graph.Insert(u, v)
+	}
+	// A library in every component, and roads along a spanning tree.
+	graph.EachComponent(func(vertices []int32, roads [][2]int32) bool {
+		p.Libraries = append(p.Libraries, vertices[0])
+		p.Roads = append(p.Roads, roads...)
+		return true
+	})
+	// Cities with no roads are not in the graph at all.
+	for v := int32(1); v <= order; v++ {
+		if _, ok := graph.adjacency[v]; !ok {
+			p.Libraries = append(p.Libraries, v)
+		}
+	}
+	slices.Sort(p.Libraries)
+	p.Cost = int64(len(p.Libraries))*int64(library) + int64(len(p.Roads))*int64(road)
+	return p
+}
+
+// PlanWithCosts returns a cheapest plan for giving every one of the cities
+// 1 through n access to a library, where building a library in city v
+// costs libraries[v - 1], and repairing a road costs its weight. It panics
+// if a cost is negative, or a road does not join two different cities.
+//
+// A virtual vertex 0 joined to every city by an edge weighing the cost of
+// its library turns the problem into a minimum spanning tree: every city
+// reaches vertex 0 through the tree, either along its own library edge or
+// along roads to a city whose library edge is in the tree.
+func PlanWithCosts(libraries []int64, roads []Edge[int64]) RoadsPlan {
+	order := int32(len(libraries))
+	g := NewWeightedGraph[int64]()
+	for i, cost := range libraries {
+		if cost < 0 {
+			panic(fmt.Sprintf("library in city %d has a negative cost %d", i+1, cost))
+		}
+		g.Insert(0, int32(i+1), cost)
+	}
+	for _, r := range roads {
+		if r.U < 1 || r.U > order || r.V < 1 || r.V > order || r.U == r.V {
+			panic(fmt.Sprintf("road (%d, %d) does not join two of the cities 1 through %d", r.U, r.V, order))
+		}
+		if r.Weight < 0 {
+			panic(fmt.Sprintf("road (%d, %d) has a negative cost %d", r.U, r.V, r.Weight))
+		}
+		// Of two roads between the same cities, repair the cheaper.
+		if w, ok := g.Weight(r.U, r.V); !ok || r.Weight < w {
+			g.Insert(r.U, r.V, r.Weight)
+		}
+	}
+
+	var p RoadsPlan
+	tree := g.Kruskal()
+	repaired := make([][]int32, order+1)
+	for _, e := range tree.Edges {
+		if e.U == 0 {
+			p.Libraries = append(p.Libraries, e.V)
+		} else {
+			repaired[e.U] = append(repaired[e.U], e.V)
+			repaired[e.V] = append(repaired[e.V], e.U)
+		}
+	}
+	slices.Sort(p.Libraries)
+	p.Cost = tree.Weight
+
+	// Walk out from the libraries, so that every road runs from a city that
+	// can reach a library to one that cannot yet.
+	reached := make([]bool, order+1)
+	q := slices.Clone(p.Libraries)
+	for _, v := range q {
+		reached[v] = true
+	}
+	for i := 0; i < len(q); i++ {
+		u := q[i]
+		for _, v := range repaired[u] {
+			if !reached[v] {
+				reached[v] = true
+				p.Roads = append(p.Roads, [2]int32{u, v})
+				q = append(q, v)
+			}
+		}
+	}
+	return p
+}
+
+// Validate checks that the plan builds libraries only in the cities 1
+// through order, repairs only roads among edges, and gives every city a
+// library or a path of repaired roads to one, and that it costs what it
+// says. It does not check that the plan is the cheapest.
+func (p RoadsPlan) Validate(order int32, library int32, road int32, edges [][]int32) error {
+	return p.ValidateCosts(uniformCosts(order, library, road, edges))
+}
+
+// uniformCosts returns the costs of PlanWithCosts for the same cost of
+// every library and of every road.
+func uniformCosts(order, library, road int32, edges [][]int32) ([]int64, []Edge[int64]) {
+	libraries := make([]int64, order)
+	for i := range libraries {
+		libraries[i] = int64(library)
+	}
+	roads := make([]Edge[int64], len(edges))
+	for i, edge := range edges {
+		roads[i] = Edge[int64]{edge[0], edge[1], int64(road)}
+	}
+	return libraries, roads
+}
+
+// ValidateCosts is Validate for the costs of PlanWithCosts. A road repaired
+// where there are several costs the cheapest of them.
+func (p RoadsPlan) ValidateCosts(libraries []int64, roads []Edge[int64]) error {
+	order := int32(len(libraries))
+	city := func(v int32) bool {
+		return v >= 1 && v <= order
+	}
+	access := NewUnionFind[int32]()
+	hasLibrary := NewSet[int32]()
+	var cost int64
+	for _, v := range p.Libraries {
+		if !city(v) {
+			return fmt.Errorf("library in %d, which is not one of the cities 1 through %d", v, order)
+		}
+		if hasLibrary.Has(v) {
+			return fmt.Errorf("two libraries in city %d", v)
+		}
+		hasLibrary.Add(v)
+		cost += libraries[v-1]
+	}
+
+	cheapest := make(map[[2]int32]int64)
+	for _, r := range roads {
+		e := orderedEdge(r.U, r.V)
+		if w, ok := cheapest[e]; !ok || r.Weight < w {
+			cheapest[e] = r.Weight
+		}
+	}
+	repaired := NewSet[[2]int32]()
+	for _, r := range p.Roads {
+		e := orderedEdge(r[0], r[1])
+		w, ok := cheapest[e]
+		if !ok {
+			return fmt.Errorf("there is no road (%d, %d) to repair", r[0], r[1])
+		}
+		if repaired.Has(e) {
+			return fmt.Errorf("road (%d, %d) is repaired twice", r[0], r[1])
+		}
+		repaired.Add(e)
+		access.Union(r[0], r[1])
+		cost += w
+	}
+
+	// Every group of cities joined by repaired roads needs a library.
+	served := NewSet[int32]()
+	for _, v := range p.Libraries {
+		served.Add(access.Find(v))
+	}
+	for v := int32(1); v <= order; v++ {
+		if !served.Has(access.Find(v)) {
+			return fmt.Errorf("city %d cannot reach a library", v)
+		}
+	}
+
+	if cost != p.Cost {
+		return fmt.Errorf("the plan costs %d, not %d", cost, p.Cost)
+	}
+	return nil
+}
+
+// RoadsQuery is a single query of Roads and Libraries.
+type RoadsQuery struct {
+	Order, Library, Road int32
+	Edges                [][]int32
+}
+
+// ReadRoadsAndLibraries reads HackerRank's input: the number of queries,
+// then for each one the number of cities and roads, the cost of a library
+// and of a road, and the roads.
+func ReadRoadsAndLibraries(r io.Reader) ([]RoadsQuery, error) {
+	in := input.NewScanner(r)
+	queries := make([]RoadsQuery, in.Count(10))
+	for i := range queries {
+		q := &queries[i]
+		q.Order = int32(in.Count(100000))
+		q.Edges = make([][]int32, in.Count(100000))
+		q.Library, q.Road = in.Int32(), in.Int32()
+		for j := range q.Edges {
+			u, v := in.Int32(), in.Int32()
+			if in.Err() != nil {
+				return nil, in.Err()
+			}
+			if u < 1 || u > q.Order || v < 1 || v > q.Order || u == v {
+				in.Errorf("road (%d, %d) does not join two of the cities 1 through %d", u, v, q.Order)
+				return nil, in.Err()
+			}
+			q.Edges[j] = []int32{u, v}
+		}
+	}
+	if in.Err() != nil {
+		return nil, in.Err()
+	}
+	return queries, nil
+}
+
+// SolveRoadsAndLibraries reads HackerRank's input and writes the cost of every query.
+func SolveRoadsAndLibraries(r io.Reader, w io.Writer) error {
+	queries, err := ReadRoadsAndLibraries(r)
+	if err != nil {
+		return err
+	}
+	for _, q := range queries {
+		if _, err := fmt.Fprintln(w, RoadsAndLibraries(q.Order, q.Library, q.Road, q.Edges)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
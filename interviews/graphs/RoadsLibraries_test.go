@@ -1,193 +1,50 @@
 package graphs
 
-/*
-	https://www.hackerrank.com/challenges/torque-and-development/problem
-
-	The problem is to find the minimal spanning tree of every disconnected
-	subgraph, then determine if it's cheaper to build a library on each
-	one, or connect them by roads and build a single library.
-
-	See https://en.wikipedia.org/wiki/Disjoint-set_data_structure
-*/
+// https://www.hackerrank.com/challenges/torque-and-development/problem
 
 import (
+	"math"
 	"math/rand"
+	"slices"
 	"testing"
 
+	"github.com/abucarlo/hackerrank/interviews/graphs/generate"
 	"pgregory.net/rapid"
 )
 
-type DisjointSets map[int32]*Set[int32]
-
-type UndirectedGraph struct {
-	adjacency map[int32]*Set[int32]
-}
-
-func NewUndirectedGraph() *UndirectedGraph {
-	g := UndirectedGraph{make(map[int32]*Set[int32])}
-	return &g
-}
-
-// "size" vs. "order" cf. https://en.wikipedia.org/wiki/Graph_(discrete_mathematics)#Graph
-
-func (g *UndirectedGraph) Order() int32 {
-	return int32(len(g.adjacency))
-}
-
-func (g *UndirectedGraph) Size() int32 {
-	result := 0
-	for _, s := range g.adjacency {
-		result += s.Size()
-	}
-	// In an undirected graph, we double the edges.
-	result /= 2
-	return int32(result)
-}
-
-func FindRoot(parents map[int32]int32, v int32) int32 {
-	parent := parents[v]
-	// Follow the path to the root, compressing all the while.
-	// See https://en.wikipedia.org/wiki/Disjoint-set_data_structure#Finding_set_representatives
-	for parent != parents[parent] {
-		parent, parents[parent] = parents[parent], parents[parents[parent]]
-	}
-
-	return parent
-}
-
-func (g *UndirectedGraph) FindDisconnected() []UndirectedGraph {
-	// See mainly https://en.wikipedia.org/wiki/Kruskal%27s_algorithm
-	// I could have skipped a lot of this, since this algorithm
-	// "finds a minimum spanning forest of an undirected" graph.
-	parents := make(map[int32]int32)
-	disjoints := make(DisjointSets)
-
-	for v := range g.adjacency {
-		parents[v] = v
-		disjoints[v] = NewSet[int32]()
-		disjoints[v].Add(v)
-	}
-
-	// Now give each subgraph its adjacency matrix.
-	for u, s := range g.adjacency {
-		for _, v := range s.Items() {
-			x := FindRoot(parents, u)
-			y := FindRoot(parents, v)
-			if x == y {
-				continue
-			}
-			if disjoints[x].Size() < disjoints[y].Size() {
-				x, y = y, x
-			}
-			parents[y] = x
-			disjoints[x].Union(disjoints[y])
-			delete(disjoints, y)
-		}
-	}
-
-	var trees []UndirectedGraph
-	for _, s := range disjoints {
-		// Construct a new subgraph
-		// in which every adjacency list
-		// has a size of 1 or 2.
-		mst := NewUndirectedGraph()
-		sparents := make(map[int32]int32)
-		sdisjoints := make(DisjointSets)
-		for _, v := range s.Items() {
-			sparents[v] = v
-			sdisjoints[v] = NewSet[int32]()
-			sdisjoints[v].Add(v)
-		}
-		for _, u := range s.Items() {
-			for _, v := range g.adjacency[u].Items() {
-				x, y := FindRoot(sparents, u), FindRoot(sparents, v)
-				if x == y {
-					continue
-				}
-				if sdisjoints[x].Size() < sdisjoints[y].Size() {
-					x, y = y, x
-				}
-				sparents[y] = x
-				sdisjoints[x].Union(sdisjoints[y])
-				delete(sdisjoints, y)
-				mst.Insert(u, v)
-			}
+// shuffledVertices generates the vertices 1 through n in random order.
+func shuffledVertices(low, high int32) *rapid.Generator[[]int32] {
+	return rapid.Custom[[]int32](func(t *rapid.T) []int32 {
+		order := rapid.Int32Range(low, high).Draw(t, "order")
+		seed := rapid.Int64().Draw(t, "seed")
+		result := make([]int32, order)
+		for i := range result {
+			result[i] = int32(i + 1)
 		}
-
-		trees = append(trees, *mst)
-	}
-
-	return trees
-}
-
-func (g *UndirectedGraph) Insert(u, v int32) {
-	if u == v {
-		panic("u must not == v")
-	}
-
-	if _, ok := g.adjacency[v]; !ok {
-		g.adjacency[v] = NewSet[int32]()
-	}
-
-	if _, ok := g.adjacency[u]; !ok {
-		g.adjacency[u] = NewSet[int32]()
-	}
-
-	g.adjacency[u].Add(v)
-	g.adjacency[v].Add(u)
+		source := rand.NewSource(seed)
+		r := rand.New(source)
+		r.Shuffle(len(result), func(i, j int) { result[i], result[j] = result[j], result[i] })
+		return result
+	})
 }
 
-func roadsAndLibraries(order int32, library int32, road int32, edges [][]int32) int64 {
+// undirectedGraph builds a generated graph. Isolated vertices are left out.
+func undirectedGraph(g generate.Graph) *UndirectedGraph {
 	graph := NewUndirectedGraph()
-	for _, edge := range edges {
-		u, v := edge[0], edge[1]
-		graph.Insert(u, v)
-	}
-
-	trees := graph.FindDisconnected()
-	if library > road {
-		result := int64(0)
-		for _, t := range trees {
-			result += int64(library) + (int64(len(t.adjacency)-1))*int64(road)
-		}
-		// Correction: there might be vertices with no edges.
-		disconnected := order - int32(len(graph.adjacency))
-		result += int64(disconnected) * int64(library)
-		return result
+	for _, e := range g.Edges {
+		graph.Insert(e[0], e[1])
 	}
-
-	return int64(library) * int64(order)
+	return graph
 }
 
 // https://en.wikipedia.org/wiki/Path_graph
 func TestPathGraph(t *testing.T) {
 	f := func(t *rapid.T) {
-		path := rapid.Custom[[]int32](func(t *rapid.T) []int32 {
-			order := rapid.Int32Range(2, 9999).Draw(t, "order")
-			seed := rapid.Int64().Draw(t, "seed")
-			result := make([]int32, order)
-			for i := range result {
-				result[i] = int32(i + 1)
-			}
-			source := rand.NewSource(seed)
-			r := rand.New(source)
-			r.Shuffle(len(result), func(i, j int) { result[i], result[j] = result[j], result[i] })
-			return result
-		})
+		g := generate.Path(2, 9999).Draw(t, "path")
 
-		vertices := path.Draw(t, "path")
-
-		graph := NewUndirectedGraph()
-		for i, u := range vertices {
-			if i == 0 {
-				continue
-			}
-			graph.Insert(vertices[i-1], u)
-		}
-
-		trees := graph.FindDisconnected()
+		trees := undirectedGraph(g).FindDisconnected()
 		if len(trees) != 1 {
-			t.Errorf("A path graph should have 1 connected component, not %d", len(vertices))
+			t.Errorf("A path graph should have 1 connected component, not %d", len(trees))
 		}
 		if trees[0].Order()-1 != trees[0].Size() {
 			t.Errorf("In an MST, |V| - 1 == |E| (got %d, %d)", trees[0].Order(), trees[0].Size())
@@ -201,19 +58,11 @@ func TestPathGraph(t *testing.T) {
 func TestStarGraph(t *testing.T) {
 
 	f := func(t *rapid.T) {
-		order := rapid.Int32Range(1, 9999).Draw(t, "order")
-		v := rapid.Int32Range(1, order).Filter(func(m int32) bool { return m != order }).Draw(t, "axis")
+		g := generate.Star(2, 9999).Draw(t, "star")
 
-		graph := NewUndirectedGraph()
-		for u := int32(1); u <= order; u++ {
-			if u != v {
-				graph.Insert(u, v)
-			}
-		}
-
-		trees := graph.FindDisconnected()
+		trees := undirectedGraph(g).FindDisconnected()
 		if len(trees) != 1 {
-			t.Errorf("A star graph of %d nodes centered on %d should have 1 connected subgraph, not %d", order, v, len(trees))
+			t.Errorf("A star graph of %d nodes should have 1 connected subgraph, not %d", g.Order, len(trees))
 		}
 		if trees[0].Order()-1 != trees[0].Size() {
 			t.Errorf("In an MST, |V| - 1 == |E| (got %d, %d)", trees[0].Order(), trees[0].Size())
@@ -221,22 +70,15 @@ func TestStarGraph(t *testing.T) {
 	}
 	rapid.Check(t, f)
 }
+
 func TestStronglyConnected(t *testing.T) {
 	f := func(t *rapid.T) {
 		// There will not be more than 100000 edges.
-		order := rapid.Int32Range(2, 316).Draw(t, "order")
-		graph := NewUndirectedGraph()
-		for u := int32(1); u <= order; u++ {
-			for v := int32(1); v <= order; v++ {
-				if u != v {
-					graph.Insert(u, v)
-				}
-			}
-		}
+		g := generate.Complete(2, 316).Draw(t, "complete")
 
-		d := graph.FindDisconnected()
+		d := undirectedGraph(g).FindDisconnected()
 		if len(d) != 1 {
-			t.Errorf("A strongly connected graph of %d nodes should have 1 connected subgraph, not %d", order, len(d))
+			t.Errorf("A strongly connected graph of %d nodes should have 1 connected subgraph, not %d", g.Order, len(d))
 		}
 		for _, tree := range d {
 			if tree.Size() != tree.Order()-1 {
@@ -247,6 +89,26 @@ func TestStronglyConnected(t *testing.T) {
 	rapid.Check(t, f)
 }
 
+// FindDisconnected finds a spanning tree of every component of any graph.
+func TestFindDisconnected(t *testing.T) {
+	f := func(t *rapid.T) {
+		g := generate.Disjoint(generate.Any(100), 4).Draw(t, "graph")
+		trees := undirectedGraph(g).FindDisconnected()
+		var vertices int32
+		for _, tree := range trees {
+			if tree.Size() != tree.Order()-1 {
+				t.Fatalf("%v: MST %v has order %d, size %d", g, tree, tree.Order(), tree.Size())
+			}
+			vertices += tree.Order()
+		}
+		// Isolated vertices are not in the graph.
+		if isolated := g.Order - vertices; int32(len(trees))+isolated != g.Components {
+			t.Fatalf("%v: found %d components and %d isolated vertices", g, len(trees), isolated)
+		}
+	}
+	rapid.Check(t, f)
+}
+
 func TestSamples(t *testing.T) {
 	type Test struct {
 		n        int32
@@ -277,9 +139,183 @@ func TestSamples(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		actual := roadsAndLibraries(test.n, test.library, test.road, test.vertices)
+		actual := RoadsAndLibraries(test.n, test.library, test.road, test.vertices)
 		if actual != test.expected {
 			t.Errorf("Expected %d; got %d", test.expected, actual)
 		}
+		plan := PlanRoadsAndLibraries(test.n, test.library, test.road, test.vertices)
+		if err := plan.Validate(test.n, test.library, test.road, test.vertices); err != nil {
+			t.Errorf("Plan %v is invalid: %v", plan, err)
+		}
+		libraries, roads := uniformCosts(test.n, test.library, test.road, test.vertices)
+		plan = PlanWithCosts(libraries, roads)
+		if err := plan.Validate(test.n, test.library, test.road, test.vertices); err != nil || plan.Cost != test.expected {
+			t.Errorf("Expected a plan costing %d; got %v: %v", test.expected, plan, err)
+		}
+	}
+}
+
+func TestPlanRoadsAndLibraries(t *testing.T) {
+	f := func(t *rapid.T) {
+		g := generate.Disjoint(generate.Any(30), 3).Draw(t, "graph")
+		library := rapid.Int32Range(1, 10).Draw(t, "library")
+		road := rapid.Int32Range(1, 10).Draw(t, "road")
+		edges := g.Lists()
+
+		plan := PlanRoadsAndLibraries(g.Order, library, road, edges)
+		if err := plan.Validate(g.Order, library, road, edges); err != nil {
+			t.Fatalf("%v: plan %v is invalid: %v", g, plan, err)
+		}
+		expected := int64(g.Order) * int64(library)
+		if road < library {
+			expected = int64(g.Components)*int64(library) + int64(g.Order-g.Components)*int64(road)
+		}
+		if plan.Cost != expected {
+			t.Fatalf("%v: expected a cost of %d; got %d", g, expected, plan.Cost)
+		}
+		if r, ok := misoriented(plan); ok {
+			t.Fatalf("%v: road %v of plan %v leads to a city that already has access", g, r, plan)
+		}
+
+		plan = PlanWithCosts(uniformCosts(g.Order, library, road, edges))
+		if err := plan.Validate(g.Order, library, road, edges); err != nil || plan.Cost != expected {
+			t.Fatalf("%v: expected a plan costing %d; got %v: %v", g, expected, plan, err)
+		}
+		if r, ok := misoriented(plan); ok {
+			t.Fatalf("%v: road %v of plan %v leads to a city that already has access", g, r, plan)
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+// cheapestPlan returns the cost of the cheapest plan by trying every set of
+// libraries and roads.
+func cheapestPlan(libraries []int64, roads []Edge[int64]) int64 {
+	n, m := len(libraries), len(roads)
+	best := int64(math.MaxInt64)
+	for subset := 0; subset < 1<<(n+m); subset++ {
+		var p RoadsPlan
+		for v := range n {
+			if subset&(1<<v) != 0 {
+				p.Libraries = append(p.Libraries, int32(v+1))
+				p.Cost += libraries[v]
+			}
+		}
+		for i, r := range roads {
+			if subset&(1<<(n+i)) != 0 {
+				p.Roads = append(p.Roads, [2]int32{r.U, r.V})
+				p.Cost += r.Weight
+			}
+		}
+		if p.Cost < best && p.ValidateCosts(libraries, roads) == nil {
+			best = p.Cost
+		}
+	}
+	return best
+}
+
+func TestPlanWithCosts(t *testing.T) {
+	f := func(t *rapid.T) {
+		order := rapid.IntRange(1, 6).Draw(t, "order")
+		libraries := rapid.SliceOfN(rapid.Int64Range(1, 20), order, order).Draw(t, "libraries")
+		city := rapid.Int32Range(1, int32(order))
+		// Distinct roads, so that the oracle never repairs one twice.
+		seen := NewSet[[2]int32]()
+		var roads []Edge[int64]
+		for range rapid.IntRange(0, 8).Draw(t, "size") {
+			u, v := city.Draw(t, "u"), city.Draw(t, "v")
+			if u != v && !seen.Has(orderedEdge(u, v)) {
+				seen.Add(orderedEdge(u, v))
+				roads = append(roads, Edge[int64]{u, v, rapid.Int64Range(1, 20).Draw(t, "road")})
+			}
+		}
+
+		plan := PlanWithCosts(libraries, roads)
+		if err := plan.ValidateCosts(libraries, roads); err != nil {
+			t.Fatalf("Plan %v is invalid: %v", plan, err)
+		}
+		if expected := cheapestPlan(libraries, roads); plan.Cost != expected {
+			t.Fatalf("Expected a plan costing %d; got %v", expected, plan)
+		}
+		if r, ok := misoriented(plan); ok {
+			t.Fatalf("Road %v of plan %v leads to a city that already has access", r, plan)
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+// misoriented returns the first road of the plan that does not run from a
+// city with access to a library to one without, if there is one.
+func misoriented(p RoadsPlan) ([2]int32, bool) {
+	access := NewSet[int32]()
+	for _, v := range p.Libraries {
+		access.Add(v)
+	}
+	for _, r := range p.Roads {
+		if !access.Has(r[0]) || access.Has(r[1]) {
+			return r, true
+		}
+		access.Add(r[1])
+	}
+	return [2]int32{}, false
+}
+
+func TestPlanWithCostsOrientation(t *testing.T) {
+	plan := PlanWithCosts([]int64{10, 10, 1}, []Edge[int64]{{1, 3, 1}})
+	expected := RoadsPlan{12, []int32{2, 3}, [][2]int32{{3, 1}}}
+	if !slices.Equal(plan.Libraries, expected.Libraries) || !slices.Equal(plan.Roads, expected.Roads) || plan.Cost != expected.Cost {
+		t.Errorf("Expected %v; got %v", expected, plan)
+	}
+}
+
+func TestPlanWithCostsPanics(t *testing.T) {
+	tests := map[string]struct {
+		libraries []int64
+		roads     []Edge[int64]
+	}{
+		"City 0":           {[]int64{1, 1}, []Edge[int64]{{0, 1, 1}}},
+		"Past the cities":  {[]int64{1, 1}, []Edge[int64]{{1, 3, 1}}},
+		"Loop":             {[]int64{1, 1}, []Edge[int64]{{1, 1, 1}}},
+		"Negative road":    {[]int64{1, 1}, []Edge[int64]{{1, 2, -1}}},
+		"Negative library": {[]int64{1, -1}, nil},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Expected a panic")
+				}
+			}()
+			PlanWithCosts(test.libraries, test.roads)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	edges := [][]int32{{1, 2}, {2, 3}, {4, 5}}
+	tests := []struct {
+		name     string
+		plan     RoadsPlan
+		expected string
+	}{
+		{"Valid", RoadsPlan{7, []int32{1, 4}, [][2]int32{{1, 2}, {3, 2}, {5, 4}}}, ""},
+		{"No library", RoadsPlan{6, []int32{1}, [][2]int32{{1, 2}, {2, 3}, {4, 5}}}, "city 4 cannot reach a library"},
+		{"No road", RoadsPlan{6, []int32{1, 4}, [][2]int32{{1, 2}, {4, 5}}}, "city 3 cannot reach a library"},
+		{"Not a city", RoadsPlan{2, []int32{6}, nil}, "library in 6, which is not one of the cities 1 through 5"},
+		{"Two libraries", RoadsPlan{4, []int32{1, 1}, nil}, "two libraries in city 1"},
+		{"Not a road", RoadsPlan{7, []int32{1, 4}, [][2]int32{{1, 3}}}, "there is no road (1, 3) to repair"},
+		{"Repaired twice", RoadsPlan{7, []int32{1, 4}, [][2]int32{{1, 2}, {2, 1}}}, "road (2, 1) is repaired twice"},
+		{"Wrong cost", RoadsPlan{6, []int32{1, 4}, [][2]int32{{1, 2}, {3, 2}, {5, 4}}}, "the plan costs 7, not 6"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.plan.Validate(5, 2, 1, edges)
+			if test.expected == "" && err != nil || test.expected != "" && (err == nil || err.Error() != test.expected) {
+				t.Errorf("Expected %q; got %v", test.expected, err)
+			}
+		})
 	}
 }
@@ -0,0 +1,108 @@
+package graphs
+
+/*
+	How the cost of Roads and Libraries depends on the prices.
+
+	A group of s connected cities costs s libraries, or one library and
+	s - 1 roads. Measured in roads, with a library costing x roads, that is
+	s·x or x + s - 1, which cross at x = 1 whatever s is. So every group
+	flips at once, and the total cost is piecewise linear in x with a single
+	breakpoint at 1, unless there is no road to repair at all.
+*/
+
+import (
+	"fmt"
+	"math"
+)
+
+// Strategy is a way of giving every city access to a library.
+type Strategy int
+
+const (
+	// LibraryEverywhere builds a library in every city.
+	LibraryEverywhere Strategy = iota
+	// RoadsPerComponent builds one library in every group of connected
+	// cities, and repairs roads along a spanning tree of it.
+	RoadsPerComponent
+)
+
+func (s Strategy) String() string {
+	switch s {
+	case LibraryEverywhere:
+		return "library everywhere"
+	case RoadsPerComponent:
+		return "roads plus one library per component"
+	}
+	return fmt.Sprintf("Strategy(%d)", int(s))
+}
+
+// CostSegment is a piece of the cost function, over the ratios of the price
+// of a library to that of a road from From to To, inclusive, on which one
+// strategy is cheapest. It builds Libraries libraries and repairs Roads
+// roads, so it costs Libraries·x + Roads roads at the ratio x.
+type CostSegment struct {
+	From, To         float64
+	Strategy         Strategy
+	Libraries, Roads int64
+}
+
+// CostFunction is the cheapest cost of a city graph at every ratio of
+// prices, as segments in increasing order of ratio.
+type CostFunction []CostSegment
+
+// CostSensitivity returns the cost function of the cities 1 through order
+// joined by edges, which has one or two segments. Only the number of
+// components matters, which it counts with EachComponent.
+func CostSensitivity(order int32, edges [][]int32) CostFunction {
+	graph := NewUndirectedGraph()
+	for _, edge := range edges {
+		graph.Insert(edge[0], edge[1])
+	}
+	// Cities with no roads are components of their own.
+	components := int64(order - graph.Order())
+	graph.EachComponent(func([]int32, [][2]int32) bool {
+		components++
+		return true
+	})
+
+	everywhere := CostSegment{0, math.Inf(1), LibraryEverywhere, int64(order), 0}
+	if components == int64(order) {
+		return CostFunction{everywhere}
+	}
+	everywhere.To = 1
+	return CostFunction{
+		everywhere,
+		{1, math.Inf(1), RoadsPerComponent, components, int64(order) - components},
+	}
+}
+
+// Breakpoints returns the ratios at which the cheapest strategy changes.
+func (f CostFunction) Breakpoints() []float64 {
+	var breakpoints []float64
+	for i := 1; i < len(f); i++ {
+		breakpoints = append(breakpoints, f[i].From)
+	}
+	return breakpoints
+}
+
+// Segment returns the segment for the given prices. At a breakpoint, where
+// two segments cost the same, it returns the first. An empty function has
+// only the zero segment.
+func (f CostFunction) Segment(library, road int32) CostSegment {
+	if len(f) == 0 {
+		return CostSegment{}
+	}
+	ratio := float64(library) / float64(road)
+	for _, s := range f {
+		if ratio <= s.To {
+			return s
+		}
+	}
+	return f[len(f)-1]
+}
+
+// Cost returns the cheapest cost for the given prices.
+func (f CostFunction) Cost(library, road int32) int64 {
+	s := f.Segment(library, road)
+	return s.Libraries*int64(library) + s.Roads*int64(road)
+}
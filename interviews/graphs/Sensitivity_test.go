@@ -0,0 +1,69 @@
+package graphs
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/abucarlo/hackerrank/interviews/graphs/generate"
+	"pgregory.net/rapid"
+)
+
+func TestCostSensitivitySample(t *testing.T) {
+	// Two components of 4 and 2 cities.
+	edges := [][]int32{{1, 3}, {3, 4}, {2, 4}, {1, 2}, {2, 3}, {5, 6}}
+	f := CostSensitivity(6, edges)
+	expected := CostFunction{
+		{0, 1, LibraryEverywhere, 6, 0},
+		{1, math.Inf(1), RoadsPerComponent, 2, 4},
+	}
+	if !slices.Equal(f, expected) {
+		t.Errorf("Expected %v; got %v", expected, f)
+	}
+
+	// With no roads, a library everywhere is the only choice.
+	if f := CostSensitivity(3, nil); len(f) != 1 || len(f.Breakpoints()) != 0 {
+		t.Errorf("Expected a single segment; got %v", f)
+	}
+	if breakpoints := (CostFunction{}).Breakpoints(); len(breakpoints) != 0 {
+		t.Errorf("An empty function should have no breakpoints; got %v", breakpoints)
+	}
+	if cost := (CostFunction{}).Cost(2, 1); cost != 0 {
+		t.Errorf("An empty function should cost nothing; got %d", cost)
+	}
+}
+
+func TestCostSensitivity(t *testing.T) {
+	f := func(t *rapid.T) {
+		g := generate.Disjoint(generate.Any(30), 3).Draw(t, "graph")
+		edges := g.Lists()
+		cost := CostSensitivity(g.Order, edges)
+
+		// The function is continuous, and its segments cover every ratio.
+		if cost[0].From != 0 || !math.IsInf(cost[len(cost)-1].To, 1) {
+			t.Fatalf("%v: %v does not cover every ratio", g, cost)
+		}
+		for i := 1; i < len(cost); i++ {
+			x, s, r := cost[i].From, cost[i-1], cost[i]
+			if s.To != x || float64(s.Libraries)*x+float64(s.Roads) != float64(r.Libraries)*x+float64(r.Roads) {
+				t.Fatalf("%v: %v is not continuous at %g", g, cost, x)
+			}
+		}
+
+		// Prices at and around every breakpoint, and anywhere.
+		road := rapid.Int32Range(2, 100).Draw(t, "road")
+		prices := []int32{rapid.Int32Range(1, 1000).Draw(t, "library")}
+		for _, b := range cost.Breakpoints() {
+			at := int32(b * float64(road))
+			prices = append(prices, at-1, at, at+1)
+		}
+		for _, library := range prices {
+			expected := RoadsAndLibraries(g.Order, library, road, edges)
+			if actual := cost.Cost(library, road); actual != expected {
+				t.Fatalf("%v: at prices %d and %d, expected %d; got %d from %v", g, library, road, expected, actual, cost.Segment(library, road))
+			}
+		}
+	}
+
+	rapid.Check(t, f)
+}
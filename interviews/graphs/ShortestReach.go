@@ -0,0 +1,148 @@
+package graphs
+
+/*
+	https://www.hackerrank.com/challenges/bfs-shortest-reach/problem
+
+	Every edge has the same length, so breadth-first search visits the
+	vertices in order of their distance from the source.
+
+	See https://en.wikipedia.org/wiki/Breadth-first_search
+*/
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/abucarlo/hackerrank/interviews/input"
+)
+
+// Unreachable is the distance to a vertex with no path from the source.
+const Unreachable = -1
+
+// EdgeLength is the length of every edge in the BFS: Shortest Reach problem.
+const EdgeLength = 6
+
+// Distances returns the length of the shortest path from source to every
+// vertex of the graph, or Unreachable, given the length of every edge.
+// The source is always at distance 0, even if it has no edges. It panics
+// if length is less than 1.
+func (g *UndirectedGraph) Distances(source int32, length int32) map[int32]int32 {
+	// Unreachable also marks the vertices not yet visited, so a distance
+	// must never reach it.
+	if length < 1 {
+		panic(fmt.Sprintf("edges must have a length of at least 1, not %d", length))
+	}
+	distances := make(map[int32]int32, len(g.adjacency)+1)
+	for v := range g.adjacency {
+		distances[v] = Unreachable
+	}
+	distances[source] = 0
+	if _, ok := g.adjacency[source]; !ok {
+		return distances
+	}
+
+	q := []int32{source}
+	for len(q) > 0 {
+		u := q[0]
+		q = q[1:]
+		for _, v := range g.adjacency[u].Items() {
+			if distances[v] != Unreachable {
+				continue
+			}
+			distances[v] = distances[u] + length
+			q = append(q, v)
+		}
+	}
+	return distances
+}
+
+// ShortestReachQuery is a single query of BFS: Shortest Reach.
+type ShortestReachQuery struct {
+	Order, Source int32
+	Graph         *UndirectedGraph
+}
+
+// ShortestReach returns the distance from the source to every other one of
+// the nodes 1 through order, in order, where every edge has length 6.
+func ShortestReach(q ShortestReachQuery) []int32 {
+	distances := q.Graph.Distances(q.Source, EdgeLength)
+	result := make([]int32, 0, max(q.Order-1, 0))
+	for v := int32(1); v <= q.Order; v++ {
+		if v == q.Source {
+			continue
+		}
+		// Nodes with no edges are not in the graph.
+		d, ok := distances[v]
+		if !ok {
+			d = Unreachable
+		}
+		result = append(result, d)
+	}
+	return result
+}
+
+// ReadShortestReach reads HackerRank's input: the number of queries, then
+// for each one the number of nodes and edges, the edges, and the source.
+func ReadShortestReach(r io.Reader) ([]ShortestReachQuery, error) {
+	in := input.NewScanner(r)
+	queries := make([]ShortestReachQuery, in.Count(10))
+	for i := range queries {
+		q := &queries[i]
+		q.Order = int32(in.Count(1000))
+		q.Graph = NewUndirectedGraph()
+		size := in.Count(int(q.Order) * int(q.Order-1) / 2)
+		for range size {
+			u, v := in.Int32(), in.Int32()
+			if in.Err() != nil {
+				return nil, in.Err()
+			}
+			if u < 1 || u > q.Order || v < 1 || v > q.Order {
+				in.Errorf("edge (%d, %d) is not between nodes 1 and %d", u, v, q.Order)
+				return nil, in.Err()
+			}
+			// A loop never shortens a path.
+			if u != v {
+				q.Graph.Insert(u, v)
+			}
+		}
+		q.Source = in.Int32()
+		if in.Err() == nil && (q.Source < 1 || q.Source > q.Order) {
+			in.Errorf("source %d is not one of the nodes 1 through %d", q.Source, q.Order)
+		}
+	}
+	if in.Err() != nil {
+		return nil, in.Err()
+	}
+	return queries, nil
+}
+
+// WriteShortestReach writes the distances of every query on its own line,
+// separated by spaces.
+func WriteShortestReach(w io.Writer, distances [][]int32) error {
+	b := bufio.NewWriter(w)
+	for _, ds := range distances {
+		for i, d := range ds {
+			if i > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteString(strconv.Itoa(int(d)))
+		}
+		b.WriteByte('\n')
+	}
+	return b.Flush()
+}
+
+// SolveShortestReach reads HackerRank's input and writes the distances of every query.
+func SolveShortestReach(r io.Reader, w io.Writer) error {
+	queries, err := ReadShortestReach(r)
+	if err != nil {
+		return err
+	}
+	distances := make([][]int32, len(queries))
+	for i, q := range queries {
+		distances[i] = ShortestReach(q)
+	}
+	return WriteShortestReach(w, distances)
+}
@@ -0,0 +1,121 @@
+package graphs
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"pgregory.net/rapid"
+)
+
+func TestShortestReachSample(t *testing.T) {
+	f, err := os.Open("./bfs-shortest-reach-inputs/sample.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var out strings.Builder
+	if err := SolveShortestReach(f, &out); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "6 6 -1\n-1 6\n"; out.String() != expected {
+		t.Errorf("Expected %q; got %q", expected, out.String())
+	}
+}
+
+func TestShortestReachErrors(t *testing.T) {
+	for _, in := range []string{
+		"1\n3 1\n1 4\n1\n",
+		"1\n3 1\n1 2\n0\n",
+		"1\n3 4\n",
+		"1\n3 1\n1 2\n",
+	} {
+		if _, err := ReadShortestReach(strings.NewReader(in)); err == nil {
+			t.Errorf("%q should not be read", in)
+		}
+	}
+}
+
+func TestDistancesPathGraph(t *testing.T) {
+	f := func(t *rapid.T) {
+		vertices := shuffledVertices(2, 999).Draw(t, "path")
+		length := rapid.Int32Range(1, 100).Draw(t, "length")
+		g := NewUndirectedGraph()
+		for i := 1; i < len(vertices); i++ {
+			g.Insert(vertices[i-1], vertices[i])
+		}
+
+		distances := g.Distances(vertices[0], length)
+		if len(distances) != len(vertices) {
+			t.Fatalf("Expected %d distances; got %d", len(vertices), len(distances))
+		}
+		for i, v := range vertices {
+			if distances[v] != int32(i)*length {
+				t.Fatalf("Vertex %d should be at %d; got %d", v, int32(i)*length, distances[v])
+			}
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+func TestDistancesLength(t *testing.T) {
+	g := NewUndirectedGraph()
+	g.Insert(1, 2)
+	for _, length := range []int32{0, -1} {
+		t.Run(fmt.Sprint(length), func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Expected a panic on a length of %d", length)
+				}
+			}()
+			g.Distances(1, length)
+		})
+	}
+}
+
+func TestDistances(t *testing.T) {
+	f := func(t *rapid.T) {
+		order := rapid.Int32Range(1, 30).Draw(t, "order")
+		vertex := rapid.Int32Range(1, order)
+		g := NewUndirectedGraph()
+		for range rapid.IntRange(0, 60).Draw(t, "size") {
+			u, v := vertex.Draw(t, "u"), vertex.Draw(t, "v")
+			if u != v {
+				g.Insert(u, v)
+			}
+		}
+		source := vertex.Draw(t, "source")
+		distances := g.Distances(source, 1)
+
+		// Every edge changes the distance by at most one, and every vertex
+		// but the source is one further than one of its neighbors.
+		components := NewUnionFind[int32]()
+		for u, s := range g.adjacency {
+			components.Add(u)
+			closer := false
+			for _, v := range s.Items() {
+				components.Union(u, v)
+				if d := distances[u] - distances[v]; d < -1 || d > 1 {
+					t.Fatalf("Edge (%d, %d) joins distances %d and %d", u, v, distances[u], distances[v])
+				}
+				closer = closer || distances[v] == distances[u]-1
+			}
+			if u != source && distances[u] != Unreachable && !closer {
+				t.Fatalf("Vertex %d at %d has no neighbor closer to %d", u, distances[u], source)
+			}
+		}
+		for u := range g.adjacency {
+			if connected := components.Connected(u, source); connected != (distances[u] != Unreachable) {
+				t.Fatalf("Vertex %d is connected to %d (%t), but at distance %d", u, source, connected, distances[u])
+			}
+		}
+		if distances[source] != 0 {
+			t.Fatalf("The source should be at 0; got %d", distances[source])
+		}
+	}
+
+	rapid.Check(t, f)
+}
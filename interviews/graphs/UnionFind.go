@@ -0,0 +1,161 @@
+package graphs
+
+/*
+	A disjoint-set forest with union by size and path compression.
+
+	See https://en.wikipedia.org/wiki/Disjoint-set_data_structure
+*/
+
+// UnionFind partitions values of any comparable type into disjoint sets.
+// A value becomes a singleton set the first time it is seen, so there is
+// no need to add every value up front.
+type UnionFind[T comparable] struct {
+	parents map[T]T
+	// Only roots have a meaningful size.
+	sizes      map[T]int
+	components int
+}
+
+func NewUnionFind[T comparable]() *UnionFind[T] {
+	return &UnionFind[T]{make(map[T]T), make(map[T]int), 0}
+}
+
+// Add makes v a singleton set, unless it is already present.
+func (u *UnionFind[T]) Add(v T) {
+	if _, ok := u.parents[v]; ok {
+		return
+	}
+	u.parents[v] = v
+	u.sizes[v] = 1
+	u.components++
+}
+
+func (u *UnionFind[T]) Has(v T) bool {
+	_, ok := u.parents[v]
+	return ok
+}
+
+// Find returns the representative of the set containing v.
+func (u *UnionFind[T]) Find(v T) T {
+	u.Add(v)
+	parent := u.parents[v]
+	// Follow the path to the root, compressing all the while.
+	// See https://en.wikipedia.org/wiki/Disjoint-set_data_structure#Finding_set_representatives
+	for parent != u.parents[parent] {
+		parent, u.parents[parent] = u.parents[parent], u.parents[u.parents[parent]]
+	}
+	u.parents[v] = parent
+	return parent
+}
+
+// Union merges the sets containing x and y, and reports whether they
+// were previously disjoint.
+func (u *UnionFind[T]) Union(x, y T) bool {
+	x, y = u.Find(x), u.Find(y)
+	if x == y {
+		return false
+	}
+	// Combine the smaller set into the larger one.
+	if u.sizes[x] < u.sizes[y] {
+		x, y = y, x
+	}
+	u.parents[y] = x
+	u.sizes[x] += u.sizes[y]
+	delete(u.sizes, y)
+	u.components--
+	return true
+}
+
+func (u *UnionFind[T]) Connected(x, y T) bool {
+	return u.Find(x) == u.Find(y)
+}
+
+// ComponentSize returns the number of values in the set containing v.
+func (u *UnionFind[T]) ComponentSize(v T) int {
+	return u.sizes[u.Find(v)]
+}
+
+// Count returns the number of disjoint sets.
+func (u *UnionFind[T]) Count() int {
+	return u.components
+}
+
+// Len returns the number of values in all sets.
+func (u *UnionFind[T]) Len() int {
+	return len(u.parents)
+}
+
+// Components returns the members of every set, keyed by representative.
+func (u *UnionFind[T]) Components() map[T][]T {
+	result := make(map[T][]T, u.components)
+	for v := range u.parents {
+		root := u.Find(v)
+		result[root] = append(result[root], v)
+	}
+	return result
+}
+
+// DenseUnionFind is a UnionFind over the ids 0 through n - 1, backed
+// by slices rather than maps.
+type DenseUnionFind struct {
+	parents    []int32
+	sizes      []int32
+	components int
+}
+
+func NewDenseUnionFind(n int32) *DenseUnionFind {
+	u := DenseUnionFind{make([]int32, n), make([]int32, n), int(n)}
+	for v := range u.parents {
+		u.parents[v] = int32(v)
+		u.sizes[v] = 1
+	}
+	return &u
+}
+
+func (u *DenseUnionFind) Find(v int32) int32 {
+	parent := u.parents[v]
+	for parent != u.parents[parent] {
+		parent, u.parents[parent] = u.parents[parent], u.parents[u.parents[parent]]
+	}
+	u.parents[v] = parent
+	return parent
+}
+
+func (u *DenseUnionFind) Union(x, y int32) bool {
+	x, y = u.Find(x), u.Find(y)
+	if x == y {
+		return false
+	}
+	if u.sizes[x] < u.sizes[y] {
+		x, y = y, x
+	}
+	u.parents[y] = x
+	u.sizes[x] += u.sizes[y]
+	u.components--
+	return true
+}
+
+func (u *DenseUnionFind) Connected(x, y int32) bool {
+	return u.Find(x) == u.Find(y)
+}
+
+func (u *DenseUnionFind) ComponentSize(v int32) int {
+	return int(u.sizes[u.Find(v)])
+}
+
+func (u *DenseUnionFind) Count() int {
+	return u.components
+}
+
+func (u *DenseUnionFind) Len() int {
+	return len(u.parents)
+}
+
+func (u *DenseUnionFind) Components() map[int32][]int32 {
+	result := make(map[int32][]int32, u.components)
+	for v := range u.parents {
+		root := u.Find(int32(v))
+		result[root] = append(result[root], int32(v))
+	}
+	return result
+}
@@ -0,0 +1,85 @@
+package graphs
+
+import (
+	"testing"
+
+	"pgregory.net/rapid"
+)
+
+// relabel is the naive alternative: every element carries
+// a component label, and a union relabels one component.
+func relabel(labels []int32, x, y int32) {
+	from, to := labels[y], labels[x]
+	for i, l := range labels {
+		if l == from {
+			labels[i] = to
+		}
+	}
+}
+
+func TestUnionFind(t *testing.T) {
+	f := func(t *rapid.T) {
+		order := rapid.Int32Range(1, 200).Draw(t, "order")
+		vertex := rapid.Int32Range(0, order-1)
+		unions := rapid.SliceOf(rapid.SliceOfN(vertex, 2, 2)).Draw(t, "unions")
+
+		sparse := NewUnionFind[int32]()
+		dense := NewDenseUnionFind(order)
+		labels := make([]int32, order)
+		for v := range order {
+			sparse.Add(v)
+			labels[v] = v
+		}
+
+		for _, pair := range unions {
+			x, y := pair[0], pair[1]
+			disjoint := labels[x] != labels[y]
+			relabel(labels, x, y)
+			if sparse.Union(x, y) != disjoint {
+				t.Fatalf("Union(%d, %d) should report %t", x, y, disjoint)
+			}
+			if dense.Union(x, y) != disjoint {
+				t.Fatalf("Dense Union(%d, %d) should report %t", x, y, disjoint)
+			}
+		}
+
+		sizes := make(map[int32]int)
+		for _, l := range labels {
+			sizes[l]++
+		}
+		if sparse.Count() != len(sizes) || dense.Count() != len(sizes) {
+			t.Fatalf("Expected %d components; got %d and %d", len(sizes), sparse.Count(), dense.Count())
+		}
+		if len(sparse.Components()) != len(sizes) || len(dense.Components()) != len(sizes) {
+			t.Fatalf("Expected %d components; got %v and %v", len(sizes), sparse.Components(), dense.Components())
+		}
+
+		for u := range order {
+			if sparse.ComponentSize(u) != sizes[labels[u]] || dense.ComponentSize(u) != sizes[labels[u]] {
+				t.Fatalf("Vertex %d should be in a component of size %d", u, sizes[labels[u]])
+			}
+			for v := range order {
+				expected := labels[u] == labels[v]
+				if sparse.Connected(u, v) != expected || dense.Connected(u, v) != expected {
+					t.Fatalf("Connected(%d, %d) should be %t", u, v, expected)
+				}
+			}
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+func TestUnionFindImplicitAdd(t *testing.T) {
+	u := NewUnionFind[string]()
+	if u.Connected("a", "b") {
+		t.Errorf("New values should be singletons")
+	}
+	u.Union("b", "c")
+	if u.Len() != 3 || u.Count() != 2 {
+		t.Errorf("Expected 3 values in 2 sets; got %d in %d", u.Len(), u.Count())
+	}
+	if u.ComponentSize("c") != 2 {
+		t.Errorf("Expected {b, c} to have size 2; got %d", u.ComponentSize("c"))
+	}
+}
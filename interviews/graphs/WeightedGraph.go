@@ -0,0 +1,230 @@
+package graphs
+
+/*
+	Minimum spanning forests of a weighted undirected graph, three ways.
+
+	See https://en.wikipedia.org/wiki/Minimum_spanning_tree
+*/
+
+import (
+	"cmp"
+	"container/heap"
+	"slices"
+
+	"golang.org/x/exp/constraints"
+)
+
+type Weight interface {
+	constraints.Integer | constraints.Float
+}
+
+// Edge is an undirected edge. Edges returned by WeightedGraph always have U < V.
+type Edge[W Weight] struct {
+	U, V   int32
+	Weight W
+}
+
+// compareEdges orders edges by weight, then by their vertices, so that
+// every algorithm breaks ties between equal weights the same way.
+func compareEdges[W Weight](a, b Edge[W]) int {
+	if c := cmp.Compare(a.Weight, b.Weight); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.U, b.U); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.V, b.V)
+}
+
+func newEdge[W Weight](u, v int32, w W) Edge[W] {
+	if u > v {
+		u, v = v, u
+	}
+	return Edge[W]{u, v, w}
+}
+
+// WeightedGraph is a simple undirected graph with a weight on every edge.
+type WeightedGraph[W Weight] struct {
+	adjacency map[int32]map[int32]W
+}
+
+func NewWeightedGraph[W Weight]() *WeightedGraph[W] {
+	return &WeightedGraph[W]{make(map[int32]map[int32]W)}
+}
+
+// AddVertex adds a vertex with no edges.
+func (g *WeightedGraph[W]) AddVertex(v int32) {
+	if _, ok := g.adjacency[v]; !ok {
+		g.adjacency[v] = make(map[int32]W)
+	}
+}
+
+// Insert adds an edge, or replaces the weight of an existing one.
+func (g *WeightedGraph[W]) Insert(u, v int32, w W) {
+	if u == v {
+		panic("u must not == v")
+	}
+	g.AddVertex(u)
+	g.AddVertex(v)
+	g.adjacency[u][v] = w
+	g.adjacency[v][u] = w
+}
+
+func (g *WeightedGraph[W]) Order() int32 {
+	return int32(len(g.adjacency))
+}
+
+func (g *WeightedGraph[W]) Size() int32 {
+	result := 0
+	for _, m := range g.adjacency {
+		result += len(m)
+	}
+	return int32(result / 2)
+}
+
+// Weight returns the weight of the edge between u and v, if there is one.
+func (g *WeightedGraph[W]) Weight(u, v int32) (W, bool) {
+	w, ok := g.adjacency[u][v]
+	return w, ok
+}
+
+// Vertices returns every vertex in ascending order.
+func (g *WeightedGraph[W]) Vertices() []int32 {
+	result := make([]int32, 0, len(g.adjacency))
+	for v := range g.adjacency {
+		result = append(result, v)
+	}
+	slices.Sort(result)
+	return result
+}
+
+// Edges returns every edge, sorted by weight.
+func (g *WeightedGraph[W]) Edges() []Edge[W] {
+	result := make([]Edge[W], 0, g.Size())
+	for u, m := range g.adjacency {
+		for v, w := range m {
+			if u < v {
+				result = append(result, Edge[W]{u, v, w})
+			}
+		}
+	}
+	slices.SortFunc(result, compareEdges[W])
+	return result
+}
+
+// SpanningForest is a minimum spanning tree of every connected
+// component. Its edges are sorted by weight.
+type SpanningForest[W Weight] struct {
+	Edges  []Edge[W]
+	Weight W
+}
+
+func (f *SpanningForest[W]) add(e Edge[W]) {
+	f.Edges = append(f.Edges, e)
+	f.Weight += e.Weight
+}
+
+// Kruskal adds edges from lightest to heaviest, skipping any
+// that would close a cycle.
+// See https://en.wikipedia.org/wiki/Kruskal%27s_algorithm
+func (g *WeightedGraph[W]) Kruskal() SpanningForest[W] {
+	var forest SpanningForest[W]
+	disjoints := NewUnionFind[int32]()
+	for _, e := range g.Edges() {
+		if disjoints.Union(e.U, e.V) {
+			forest.add(e)
+		}
+	}
+	return forest
+}
+
+// edgeHeap is a priority queue of edges for Prim's algorithm.
+type edgeHeap[W Weight] []Edge[W]
+
+func (h edgeHeap[W]) Len() int           { return len(h) }
+func (h edgeHeap[W]) Less(i, j int) bool { return compareEdges(h[i], h[j]) < 0 }
+func (h edgeHeap[W]) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *edgeHeap[W]) Push(x any)        { *h = append(*h, x.(Edge[W])) }
+func (h *edgeHeap[W]) Pop() any {
+	old := *h
+	e := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return e
+}
+
+// Prim grows a tree from a single vertex, always adding the lightest
+// edge leaving it. It starts a new tree in every component.
+// See https://en.wikipedia.org/wiki/Prim%27s_algorithm
+func (g *WeightedGraph[W]) Prim() SpanningForest[W] {
+	var forest SpanningForest[W]
+	visited := NewSet[int32]()
+	h := &edgeHeap[W]{}
+
+	visit := func(u int32) {
+		visited.Add(u)
+		for v, w := range g.adjacency[u] {
+			if !visited.Has(v) {
+				heap.Push(h, newEdge(u, v, w))
+			}
+		}
+	}
+
+	for _, root := range g.Vertices() {
+		if visited.Has(root) {
+			continue
+		}
+		visit(root)
+		for h.Len() > 0 {
+			e := heap.Pop(h).(Edge[W])
+			u, v := e.U, e.V
+			if visited.Has(u) && visited.Has(v) {
+				continue
+			}
+			if visited.Has(v) {
+				u, v = v, u
+			}
+			forest.add(e)
+			visit(v)
+		}
+	}
+
+	slices.SortFunc(forest.Edges, compareEdges[W])
+	return forest
+}
+
+// Boruvka finds the lightest edge leaving every component, adds them all,
+// and repeats until no component has an edge leaving it.
+// See https://en.wikipedia.org/wiki/Bor%C5%AFvka%27s_algorithm
+func (g *WeightedGraph[W]) Boruvka() SpanningForest[W] {
+	var forest SpanningForest[W]
+	disjoints := NewUnionFind[int32]()
+	edges := g.Edges()
+
+	for {
+		cheapest := make(map[int32]Edge[W])
+		for _, e := range edges {
+			x, y := disjoints.Find(e.U), disjoints.Find(e.V)
+			if x == y {
+				continue
+			}
+			for _, root := range []int32{x, y} {
+				if c, ok := cheapest[root]; !ok || compareEdges(e, c) < 0 {
+					cheapest[root] = e
+				}
+			}
+		}
+		if len(cheapest) == 0 {
+			break
+		}
+		// Two components may share their cheapest edge; the
+		// union-find keeps it from being added twice.
+		for _, e := range cheapest {
+			if disjoints.Union(e.U, e.V) {
+				forest.add(e)
+			}
+		}
+	}
+
+	slices.SortFunc(forest.Edges, compareEdges[W])
+	return forest
+}
@@ -0,0 +1,143 @@
+package graphs
+
+import (
+	"slices"
+	"testing"
+
+	"pgregory.net/rapid"
+)
+
+// weightedGraph generates a graph on the vertices 1 through n, where
+// every vertex may be isolated and weights are often repeated.
+func weightedGraph(t *rapid.T) *WeightedGraph[int] {
+	order := rapid.Int32Range(1, 40).Draw(t, "order")
+	vertex := rapid.Int32Range(1, order)
+	g := NewWeightedGraph[int]()
+	for v := int32(1); v <= order; v++ {
+		g.AddVertex(v)
+	}
+	size := rapid.IntRange(0, 120).Draw(t, "size")
+	for range size {
+		u, v := vertex.Draw(t, "u"), vertex.Draw(t, "v")
+		if u != v {
+			g.Insert(u, v, rapid.IntRange(-20, 20).Draw(t, "weight"))
+		}
+	}
+	return g
+}
+
+// maximumOnPath finds the heaviest edge on the path from u to v in the
+// forest, or reports that u and v are not connected.
+func maximumOnPath(forest []Edge[int], u, v int32) (int, bool) {
+	adjacency := make(map[int32][]Edge[int])
+	for _, e := range forest {
+		adjacency[e.U] = append(adjacency[e.U], e)
+		adjacency[e.V] = append(adjacency[e.V], e)
+	}
+	heaviest := map[int32]int{u: 0}
+	q := []int32{u}
+	for len(q) > 0 {
+		x := q[0]
+		q = q[1:]
+		for _, e := range adjacency[x] {
+			y := e.U + e.V - x
+			if _, ok := heaviest[y]; ok {
+				continue
+			}
+			heaviest[y] = e.Weight
+			if x != u {
+				heaviest[y] = max(heaviest[x], e.Weight)
+			}
+			q = append(q, y)
+		}
+	}
+	w, ok := heaviest[v]
+	return w, ok
+}
+
+func TestMinimumSpanningForest(t *testing.T) {
+	f := func(t *rapid.T) {
+		g := weightedGraph(t)
+
+		kruskal := g.Kruskal()
+		prim := g.Prim()
+		boruvka := g.Boruvka()
+
+		if kruskal.Weight != prim.Weight || kruskal.Weight != boruvka.Weight {
+			t.Fatalf("Kruskal, Prim and Borůvka should agree; got %d, %d and %d", kruskal.Weight, prim.Weight, boruvka.Weight)
+		}
+		// Ties are broken the same way, so the forest is unique.
+		if !slices.Equal(kruskal.Edges, prim.Edges) || !slices.Equal(kruskal.Edges, boruvka.Edges) {
+			t.Fatalf("Kruskal, Prim and Borůvka should find the same forest; got\n%v\n%v\n%v", kruskal.Edges, prim.Edges, boruvka.Edges)
+		}
+
+		components := NewUnionFind[int32]()
+		for _, v := range g.Vertices() {
+			components.Add(v)
+		}
+		for _, e := range g.Edges() {
+			components.Union(e.U, e.V)
+		}
+		if int32(len(kruskal.Edges)) != g.Order()-int32(components.Count()) {
+			t.Fatalf("A spanning forest of %d vertices in %d components should have %d edges, not %d",
+				g.Order(), components.Count(), g.Order()-int32(components.Count()), len(kruskal.Edges))
+		}
+
+		// The cycle property: no edge outside the forest is lighter
+		// than any edge on the path in the forest between its ends.
+		// See https://en.wikipedia.org/wiki/Minimum_spanning_tree#Cycle_property
+		for _, e := range g.Edges() {
+			if w, ok := g.Weight(e.U, e.V); !ok || w != e.Weight {
+				t.Fatalf("Edge %v is not in the graph", e)
+			}
+			heaviest, ok := maximumOnPath(kruskal.Edges, e.U, e.V)
+			if !ok {
+				t.Fatalf("Edge %v joins two trees of the forest", e)
+			}
+			if heaviest > e.Weight {
+				t.Fatalf("Edge %v is lighter than an edge of weight %d in the forest", e, heaviest)
+			}
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+func TestWeightedPathGraph(t *testing.T) {
+	f := func(t *rapid.T) {
+		vertices := shuffledVertices(2, 999).Draw(t, "path")
+		weights := rapid.SliceOfN(rapid.Float64Range(0, 1), len(vertices)-1, len(vertices)-1).Draw(t, "weights")
+
+		g := NewWeightedGraph[float64]()
+		for i, w := range weights {
+			g.Insert(vertices[i], vertices[i+1], w)
+		}
+
+		// A path is its own spanning tree.
+		for _, forest := range []SpanningForest[float64]{g.Kruskal(), g.Prim(), g.Boruvka()} {
+			if int32(len(forest.Edges)) != g.Size() {
+				t.Fatalf("Expected %d edges; got %d", g.Size(), len(forest.Edges))
+			}
+		}
+	}
+
+	rapid.Check(t, f)
+}
+
+func TestMinimumSpanningTreeSample(t *testing.T) {
+	// https://en.wikipedia.org/wiki/Kruskal%27s_algorithm#Example
+	g := NewWeightedGraph[int32]()
+	for _, e := range []Edge[int32]{
+		{1, 2, 7}, {1, 4, 5}, {2, 3, 8}, {2, 4, 9}, {2, 5, 7}, {3, 5, 5},
+		{4, 5, 15}, {4, 6, 6}, {5, 6, 8}, {5, 7, 9}, {6, 7, 11},
+	} {
+		g.Insert(e.U, e.V, e.Weight)
+	}
+
+	expected := []Edge[int32]{{1, 4, 5}, {3, 5, 5}, {4, 6, 6}, {1, 2, 7}, {2, 5, 7}, {5, 7, 9}}
+	for name, forest := range map[string]SpanningForest[int32]{"Kruskal": g.Kruskal(), "Prim": g.Prim(), "Borůvka": g.Boruvka()} {
+		if forest.Weight != 39 || !slices.Equal(forest.Edges, expected) {
+			t.Errorf("%s expected %v of weight 39; got %v of weight %d", name, expected, forest.Edges, forest.Weight)
+		}
+	}
+}
@@ -0,0 +1,3 @@
+// Package graphs solves the "Graphs" problems of the HackerRank Interview
+// Preparation Kit, and provides the graph types and algorithms they share.
+package graphs
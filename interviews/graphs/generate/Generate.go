@@ -0,0 +1,261 @@
+// Package generate provides rapid generators for standard families of
+// undirected graphs, each with the invariants its family guarantees, so
+// that any graph solver can be property-tested against them:
+//
+//	g := generate.Any(100).Draw(t, "graph")
+//
+// Every graph has the vertices 1 through Order, as in HackerRank's inputs,
+// labeled in random order so that no solver can depend on the labels.
+// Isolated vertices appear in no edge.
+package generate
+
+import (
+	"fmt"
+	"math/rand"
+
+	"pgregory.net/rapid"
+)
+
+// Graph is a simple undirected graph and its invariants.
+type Graph struct {
+	// Family names the kind of graph, e.g. "path(5)".
+	Family string
+	Order  int32
+	// Edges has Size pairs of distinct vertices, with no pair repeated.
+	Edges      [][2]int32
+	Size       int32
+	Components int32
+	// Bipartite is whether the vertices can be 2-colored so that every
+	// edge joins two colors.
+	Bipartite bool
+}
+
+func (g Graph) String() string {
+	return fmt.Sprintf("%s: %d vertices, %d edges, %d components", g.Family, g.Order, g.Size, g.Components)
+}
+
+// Lists returns the edges as HackerRank's lists of two vertices.
+func (g Graph) Lists() [][]int32 {
+	lists := make([][]int32, len(g.Edges))
+	for i, e := range g.Edges {
+		lists[i] = []int32{e[0], e[1]}
+	}
+	return lists
+}
+
+// Endpoints returns the edges as HackerRank's parallel arrays of endpoints.
+func (g Graph) Endpoints() ([]int32, []int32) {
+	from, to := make([]int32, len(g.Edges)), make([]int32, len(g.Edges))
+	for i, e := range g.Edges {
+		from[i], to[i] = e[0], e[1]
+	}
+	return from, to
+}
+
+// family draws the order of a graph, builds its edges among the vertices
+// 0 through order - 1, and relabels them 1 through order in random order.
+func family(low, high int32, build func(t *rapid.T, order int32) Graph) *rapid.Generator[Graph] {
+	return rapid.Custom(func(t *rapid.T) Graph {
+		order := rapid.Int32Range(low, high).Draw(t, "order")
+		g := build(t, order)
+		g.Order, g.Size = order, int32(len(g.Edges))
+		relabel(t, &g)
+		return g
+	})
+}
+
+func relabel(t *rapid.T, g *Graph) {
+	// A seed shuffles large graphs far faster than drawing every swap.
+	r := rand.New(rand.NewSource(rapid.Int64().Draw(t, "seed")))
+	labels := r.Perm(int(g.Order))
+	for i, e := range g.Edges {
+		g.Edges[i] = [2]int32{int32(labels[e[0]]) + 1, int32(labels[e[1]]) + 1}
+	}
+}
+
+// Path generates paths of low through high vertices.
+// See https://en.wikipedia.org/wiki/Path_graph
+func Path(low, high int32) *rapid.Generator[Graph] {
+	return family(max(low, 1), high, func(t *rapid.T, order int32) Graph {
+		g := Graph{Family: fmt.Sprintf("path(%d)", order), Components: 1, Bipartite: true}
+		for v := int32(1); v < order; v++ {
+			g.Edges = append(g.Edges, [2]int32{v - 1, v})
+		}
+		return g
+	})
+}
+
+// Star generates a center joined to every other of low through high vertices.
+// See https://en.wikipedia.org/wiki/Star_(graph_theory)
+func Star(low, high int32) *rapid.Generator[Graph] {
+	return family(max(low, 1), high, func(t *rapid.T, order int32) Graph {
+		g := Graph{Family: fmt.Sprintf("star(%d)", order), Components: 1, Bipartite: true}
+		for v := int32(1); v < order; v++ {
+			g.Edges = append(g.Edges, [2]int32{0, v})
+		}
+		return g
+	})
+}
+
+// Cycle generates cycles of low through high vertices, at least 3.
+// See https://en.wikipedia.org/wiki/Cycle_graph
+func Cycle(low, high int32) *rapid.Generator[Graph] {
+	return family(max(low, 3), high, func(t *rapid.T, order int32) Graph {
+		g := Graph{Family: fmt.Sprintf("cycle(%d)", order), Components: 1, Bipartite: order%2 == 0}
+		for v := range order {
+			g.Edges = append(g.Edges, [2]int32{v, (v + 1) % order})
+		}
+		return g
+	})
+}
+
+// Complete generates graphs of low through high vertices with every edge.
+// See https://en.wikipedia.org/wiki/Complete_graph
+func Complete(low, high int32) *rapid.Generator[Graph] {
+	return family(max(low, 1), high, func(t *rapid.T, order int32) Graph {
+		g := Graph{Family: fmt.Sprintf("complete(%d)", order), Components: 1, Bipartite: order <= 2}
+		for u := range order {
+			for v := u + 1; v < order; v++ {
+				g.Edges = append(g.Edges, [2]int32{u, v})
+			}
+		}
+		return g
+	})
+}
+
+// Grid generates rectangular lattices of 1 through side rows and columns,
+// in which every vertex is joined to the ones above, below and beside it.
+// See https://en.wikipedia.org/wiki/Lattice_graph
+func Grid(side int32) *rapid.Generator[Graph] {
+	return rapid.Custom(func(t *rapid.T) Graph {
+		rows := rapid.Int32Range(1, side).Draw(t, "rows")
+		columns := rapid.Int32Range(1, side).Draw(t, "columns")
+		g := Graph{
+			Family:     fmt.Sprintf("grid(%d, %d)", rows, columns),
+			Order:      rows * columns,
+			Components: 1,
+			Bipartite:  true,
+		}
+		for i := range rows {
+			for j := range columns {
+				v := i*columns + j
+				if j+1 < columns {
+					g.Edges = append(g.Edges, [2]int32{v, v + 1})
+				}
+				if i+1 < rows {
+					g.Edges = append(g.Edges, [2]int32{v, v + columns})
+				}
+			}
+		}
+		g.Size = int32(len(g.Edges))
+		relabel(t, &g)
+		return g
+	})
+}
+
+// Tree generates random trees of low through high vertices, in which every
+// vertex but the first is joined to one drawn before it.
+// See https://en.wikipedia.org/wiki/Tree_(graph_theory)
+func Tree(low, high int32) *rapid.Generator[Graph] {
+	return family(max(low, 1), high, func(t *rapid.T, order int32) Graph {
+		g := Graph{Family: fmt.Sprintf("tree(%d)", order), Components: 1, Bipartite: true}
+		for v := int32(1); v < order; v++ {
+			g.Edges = append(g.Edges, [2]int32{rapid.Int32Range(0, v-1).Draw(t, "parent"), v})
+		}
+		return g
+	})
+}
+
+// Random generates Erdős–Rényi graphs of low through high vertices, in
+// which every edge is present with probability p. Their components are
+// counted, and whether they are bipartite decided, as they are built.
+// See https://en.wikipedia.org/wiki/Erd%C5%91s%E2%80%93R%C3%A9nyi_model
+func Random(low, high int32, p float64) *rapid.Generator[Graph] {
+	return family(max(low, 1), high, func(t *rapid.T, order int32) Graph {
+		g := Graph{Family: fmt.Sprintf("G(%d, %g)", order, p), Bipartite: true}
+		r := rand.New(rand.NewSource(rapid.Int64().Draw(t, "edges")))
+		// A union-find tracks components, and the parity of the path from
+		// every vertex to its root tracks the 2-coloring.
+		parent := make([]int32, order)
+		parity := make([]bool, order)
+		for v := range parent {
+			parent[v] = int32(v)
+		}
+		var find func(v int32) (int32, bool)
+		find = func(v int32) (int32, bool) {
+			if parent[v] == v {
+				return v, false
+			}
+			root, odd := find(parent[v])
+			parent[v], parity[v] = root, parity[v] != odd
+			return root, parity[v]
+		}
+
+		g.Components = order
+		for u := range order {
+			for v := u + 1; v < order; v++ {
+				if r.Float64() >= p {
+					continue
+				}
+				g.Edges = append(g.Edges, [2]int32{u, v})
+				x, ox := find(u)
+				y, oy := find(v)
+				if x != y {
+					parent[x], parity[x] = y, ox == oy
+					g.Components--
+				} else if ox == oy {
+					g.Bipartite = false
+				}
+			}
+		}
+		return g
+	})
+}
+
+// Any generates a graph of any family above, of up to about high vertices.
+func Any(high int32) *rapid.Generator[Graph] {
+	side := int32(1)
+	for (side+1)*(side+1) <= high {
+		side++
+	}
+	return rapid.OneOf(
+		Path(1, high),
+		Star(1, high),
+		Cycle(3, max(high, 3)),
+		Complete(1, min(high, 100)),
+		Grid(side),
+		Tree(1, high),
+		Random(1, min(high, 100), 0.1),
+	)
+}
+
+// Disjoint generates the disjoint union of 1 through parts graphs drawn
+// from g, which has the invariants of its parts added together.
+// See https://en.wikipedia.org/wiki/Disjoint_union_of_graphs
+func Disjoint(g *rapid.Generator[Graph], parts int) *rapid.Generator[Graph] {
+	return rapid.Custom(func(t *rapid.T) Graph {
+		union := Graph{Family: "union(", Bipartite: true}
+		for i := range rapid.IntRange(1, parts).Draw(t, "parts") {
+			part := g.Draw(t, "part")
+			if i > 0 {
+				union.Family += ", "
+			}
+			union.Family += part.Family
+			for _, e := range part.Edges {
+				union.Edges = append(union.Edges, [2]int32{e[0] + union.Order, e[1] + union.Order})
+			}
+			union.Order += part.Order
+			union.Size += part.Size
+			union.Components += part.Components
+			union.Bipartite = union.Bipartite && part.Bipartite
+		}
+		union.Family += ")"
+
+		// Relabel the union as a whole, so that the parts mix.
+		for i, e := range union.Edges {
+			union.Edges[i] = [2]int32{e[0] - 1, e[1] - 1}
+		}
+		relabel(t, &union)
+		return union
+	})
+}
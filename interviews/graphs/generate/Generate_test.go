@@ -0,0 +1,73 @@
+package generate_test
+
+import (
+	"testing"
+
+	"github.com/abucarlo/hackerrank/interviews/graphs"
+	"github.com/abucarlo/hackerrank/interviews/graphs/generate"
+	"pgregory.net/rapid"
+)
+
+func checkInvariants(t *rapid.T, g generate.Graph) {
+	if int32(len(g.Edges)) != g.Size {
+		t.Fatalf("%v has %d edges", g, len(g.Edges))
+	}
+	seen := make(map[[2]int32]bool)
+	h := graphs.NewUndirectedGraph()
+	for _, e := range g.Edges {
+		u, v := min(e[0], e[1]), max(e[0], e[1])
+		if u < 1 || v > g.Order || u == v || seen[[2]int32{u, v}] {
+			t.Fatalf("%v has an invalid edge %v", g, e)
+		}
+		seen[[2]int32{u, v}] = true
+		h.Insert(u, v)
+	}
+
+	// Vertex 0 is isolated.
+	from, to := g.Endpoints()
+	if components := int32(len(graphs.NewCSRGraph(g.Order+1, from, to).FindDisconnected())) - 1; components != g.Components {
+		t.Fatalf("%v has %d components", g, components)
+	}
+	if _, _, bipartite := h.IsBipartite(); bipartite != g.Bipartite {
+		t.Fatalf("%v should be bipartite: %t", g, bipartite)
+	}
+}
+
+func TestFamilies(t *testing.T) {
+	families := map[string]*rapid.Generator[generate.Graph]{
+		"Path":     generate.Path(1, 50),
+		"Star":     generate.Star(1, 50),
+		"Cycle":    generate.Cycle(3, 50),
+		"Complete": generate.Complete(1, 20),
+		"Grid":     generate.Grid(8),
+		"Tree":     generate.Tree(1, 50),
+		"Random":   generate.Random(1, 30, 0.1),
+		"Dense":    generate.Random(1, 30, 0.5),
+		"Any":      generate.Any(50),
+		"Disjoint": generate.Disjoint(generate.Any(20), 4),
+	}
+	for name, family := range families {
+		t.Run(name, func(t *testing.T) {
+			rapid.Check(t, func(t *rapid.T) {
+				checkInvariants(t, family.Draw(t, "graph"))
+			})
+		})
+	}
+}
+
+func TestRoadsAndLibraries(t *testing.T) {
+	// With libraries cheaper than roads, every city gets one; otherwise
+	// every component gets one library and a spanning tree of roads.
+	rapid.Check(t, func(t *rapid.T) {
+		g := generate.Disjoint(generate.Any(30), 3).Draw(t, "graph")
+		library := rapid.Int32Range(1, 10).Draw(t, "library")
+		road := rapid.Int32Range(1, 10).Draw(t, "road")
+		expected := int64(g.Order) * int64(library)
+		if road < library {
+			expected = int64(g.Components)*int64(library) + int64(g.Order-g.Components)*int64(road)
+		}
+		if actual := graphs.RoadsAndLibraries(g.Order, library, road, g.Lists()); actual != expected {
+			t.Fatalf("%v: expected %d; got %d", g, expected, actual)
+		}
+	})
+}
@@ -0,0 +1,246 @@
+// Package io parses the text formats in which graphs are written into the
+// types of package graphs. Every error is an *input.Error giving the line
+// and column where the input is broken, and no input makes a parser panic.
+//
+// Every format is line-oriented: an edge or a list of successors may not
+// be split across lines, nor share a line with another.
+package io
+
+import (
+	"io"
+
+	"github.com/abucarlo/hackerrank/interviews/graphs"
+	"github.com/abucarlo/hackerrank/interviews/input"
+)
+
+// MaxOrder limits the number of vertices, and MaxSize the number of edges,
+// so that a corrupt count cannot cause a huge allocation.
+const (
+	MaxOrder = 1 << 20
+	MaxSize  = 1 << 22
+)
+
+// endLine reports an error at the next token, unless the line is over.
+func endLine(in *input.Scanner) {
+	if !in.EndOfLine() {
+		in.Errorf("unexpected %q at the end of the line", in.Word())
+	}
+}
+
+// next reports an error at the most recent token, unless another token
+// follows it on the same line.
+func next(in *input.Scanner, what string) {
+	if in.Err() == nil && in.EndOfLine() {
+		in.Errorf("the line ends before the %s", what)
+	}
+}
+
+// vertex reads a vertex, which must be one of 1 through order.
+func vertex(in *input.Scanner, order int32) int32 {
+	v := in.Int32()
+	if in.Err() == nil && (v < 1 || v > order) {
+		in.Errorf("vertex %d is not one of 1 through %d", v, order)
+	}
+	return v
+}
+
+// edge reads an edge between two of the vertices 1 through order.
+func edge(in *input.Scanner, order int32) (int32, int32) {
+	u := vertex(in, order)
+	next(in, "second vertex of the edge")
+	v := vertex(in, order)
+	if in.Err() == nil && u == v {
+		in.Errorf("edge (%d, %d) is a loop", u, v)
+	}
+	return u, v
+}
+
+// header reads the first line of an edge list: the number of vertices
+// and of edges.
+func header(in *input.Scanner) (int32, int) {
+	order := int32(in.Count(MaxOrder))
+	next(in, "number of edges")
+	size := in.Count(MaxSize)
+	endLine(in)
+	return order, size
+}
+
+// ReadEdgeList reads HackerRank's usual edge list: a line with the number
+// of vertices n and of edges m, then m lines each with an edge between two
+// of the vertices 1 through n. Since an UndirectedGraph has no isolated
+// vertices, it also returns n.
+func ReadEdgeList(r io.Reader) (int32, *graphs.UndirectedGraph, error) {
+	in := input.NewScanner(r)
+	order, size := header(in)
+	g := graphs.NewUndirectedGraph()
+	for range size {
+		if in.Err() != nil {
+			break
+		}
+		u, v := edge(in, order)
+		endLine(in)
+		if in.Err() == nil {
+			g.Insert(u, v)
+		}
+	}
+	if in.Err() == nil && in.More() {
+		in.Errorf("unexpected %q after %d edges", in.Word(), size)
+	}
+	if in.Err() != nil {
+		return 0, nil, in.Err()
+	}
+	return order, g, nil
+}
+
+// ReadWeightedEdgeList reads an edge list like ReadEdgeList, but with the
+// weight of every edge after its vertices. An edge may not appear twice.
+func ReadWeightedEdgeList(r io.Reader) (*graphs.WeightedGraph[int64], error) {
+	in := input.NewScanner(r)
+	order, size := header(in)
+	g := graphs.NewWeightedGraph[int64]()
+	for v := int32(1); v <= order; v++ {
+		g.AddVertex(v)
+	}
+	for range size {
+		if in.Err() != nil {
+			break
+		}
+		u, v := edge(in, order)
+		next(in, "weight of the edge")
+		w := in.Int64()
+		endLine(in)
+		if in.Err() != nil {
+			break
+		}
+		if _, ok := g.Weight(u, v); ok {
+			in.Errorf("edge (%d, %d) appears twice", u, v)
+			break
+		}
+		g.Insert(u, v, w)
+	}
+	if in.Err() == nil && in.More() {
+		in.Errorf("unexpected %q after %d edges", in.Word(), size)
+	}
+	if in.Err() != nil {
+		return nil, in.Err()
+	}
+	return g, nil
+}
+
+// ReadAdjacencyList reads a directed graph with one line for every vertex:
+// the vertex, then its successors. Blank lines are skipped, and a vertex
+// may not have two lines. Vertices are any int32.
+func ReadAdjacencyList(r io.Reader) (*graphs.DirectedGraph, error) {
+	in := input.NewScanner(r)
+	g := graphs.NewDirectedGraph()
+	listed := make(map[int32]bool)
+	for in.More() {
+		u := in.Int32()
+		if in.Err() != nil {
+			break
+		}
+		if listed[u] {
+			in.Errorf("vertex %d is listed twice", u)
+			break
+		}
+		listed[u] = true
+		g.AddVertex(u)
+		for !in.EndOfLine() {
+			v := in.Int32()
+			if in.Err() != nil {
+				break
+			}
+			if g.Has(u, v) {
+				in.Errorf("edge (%d, %d) appears twice", u, v)
+				break
+			}
+			g.Insert(u, v)
+		}
+	}
+	if in.Err() != nil {
+		return nil, in.Err()
+	}
+	return g, nil
+}
+
+// ReadDIMACS reads a graph in either of two DIMACS formats. Lines that
+// begin with "c" are comments. A problem line "p edge n m" is followed by
+// m lines "e u v", each an edge of weight 1; "p sp n m" is followed by m
+// arcs "a u v w" of weight w. Vertices are 1 through n.
+//
+// The graph is undirected, so an arc and its reverse must have the same
+// weight, and are one edge.
+// See http://archive.dimacs.rutgers.edu/Challenges/
+func ReadDIMACS(r io.Reader) (*graphs.WeightedGraph[int64], error) {
+	in := input.NewScanner(r)
+	var g *graphs.WeightedGraph[int64]
+	var order int32
+	var size, lines int
+	var descriptor string
+
+	for in.Err() == nil && in.More() {
+		switch kind := in.Word(); kind {
+		case "c":
+			in.SkipLine()
+		case "p":
+			if g != nil {
+				in.Errorf("there are two problem lines")
+				break
+			}
+			next(in, "kind of problem")
+			switch descriptor = in.Word(); descriptor {
+			case "edge", "sp":
+			default:
+				in.Errorf("unknown problem %q; expected edge or sp", descriptor)
+			}
+			next(in, "number of vertices")
+			order, size = header(in)
+			g = graphs.NewWeightedGraph[int64]()
+			for v := int32(1); v <= order; v++ {
+				g.AddVertex(v)
+			}
+		case "e", "a":
+			if g == nil {
+				in.Errorf("%q line before the problem line", kind)
+				break
+			}
+			if (kind == "e") != (descriptor == "edge") {
+				in.Errorf("%q line in a %s problem", kind, descriptor)
+				break
+			}
+			lines++
+			if lines > size {
+				in.Errorf("more than the %d edges in the problem line", size)
+				break
+			}
+			next(in, "first vertex of the edge")
+			u, v := edge(in, order)
+			w := int64(1)
+			if kind == "a" {
+				next(in, "weight of the arc")
+				w = in.Int64()
+			}
+			endLine(in)
+			if in.Err() != nil {
+				break
+			}
+			if existing, ok := g.Weight(u, v); ok && existing != w {
+				in.Errorf("arc (%d, %d) of weight %d reverses one of weight %d", u, v, w, existing)
+				break
+			}
+			g.Insert(u, v, w)
+		default:
+			in.Errorf("unknown line %q; expected c, p, e or a", kind)
+		}
+	}
+	if in.Err() == nil && g == nil {
+		in.Errorf("there is no problem line")
+	}
+	if in.Err() == nil && lines != size {
+		in.Errorf("expected %d edges; got %d", size, lines)
+	}
+	if in.Err() != nil {
+		return nil, in.Err()
+	}
+	return g, nil
+}
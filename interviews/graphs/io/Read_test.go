@@ -0,0 +1,157 @@
+package io
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/abucarlo/hackerrank/interviews/graphs"
+	"github.com/abucarlo/hackerrank/interviews/input"
+)
+
+func TestReadEdgeList(t *testing.T) {
+	order, g, err := ReadEdgeList(strings.NewReader("5 3\n1 2\r\n2 3\n  4 2  \n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if order != 5 || g.Order() != 4 || g.Size() != 3 {
+		t.Errorf("Expected 5 vertices, 4 with edges, and 3 edges; got %d, %d and %d", order, g.Order(), g.Size())
+	}
+}
+
+func TestReadWeightedEdgeList(t *testing.T) {
+	g, err := ReadWeightedEdgeList(strings.NewReader("4 3\n1 2 7\n2 3 -1\n1 3 5\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []graphs.Edge[int64]{{U: 2, V: 3, Weight: -1}, {U: 1, V: 3, Weight: 5}, {U: 1, V: 2, Weight: 7}}
+	if g.Order() != 4 || !slices.Equal(g.Edges(), expected) {
+		t.Errorf("Expected 4 vertices and %v; got %d and %v", expected, g.Order(), g.Edges())
+	}
+}
+
+func TestReadAdjacencyList(t *testing.T) {
+	g, err := ReadAdjacencyList(strings.NewReader("1 2 3\n\n2 3\n3\n-7 1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Order() != 4 || g.Size() != 4 || !g.Has(-7, 1) || !g.Has(2, 3) || g.Has(3, 2) {
+		t.Errorf("Expected 4 vertices and 4 edges; got %v", g.Vertices())
+	}
+}
+
+func TestReadDIMACS(t *testing.T) {
+	g, err := ReadDIMACS(strings.NewReader("c A triangle\nc and a vertex.\np edge 4 3\ne 1 2\ne 2 3\ne 3 1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Order() != 4 || g.Size() != 3 {
+		t.Errorf("Expected 4 vertices and 3 edges; got %d and %d", g.Order(), g.Size())
+	}
+
+	g, err = ReadDIMACS(strings.NewReader("p sp 3 3\na 1 2 4\nc The reverse arc\na 2 1 4\na 2 3 1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w, ok := g.Weight(1, 2); g.Size() != 2 || !ok || w != 4 {
+		t.Errorf("Expected 2 edges, (1, 2) of weight 4; got %v", g.Edges())
+	}
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		read         func(string) error
+		input        string
+		line, column int
+		message      string
+	}{
+		{edgeList, "3 2\n1 2\n2 x\n", 3, 3, "invalid syntax"},
+		{edgeList, "3 2\n1 2\n2 4\n", 3, 3, "vertex 4 is not one of 1 through 3"},
+		{edgeList, "3 2\n1 2\n2 2\n", 3, 3, "edge (2, 2) is a loop"},
+		{edgeList, "3 2\n1 2 3\n2 3\n", 2, 5, `unexpected "3" at the end of the line`},
+		{edgeList, "3 2\n1\n2 3\n", 2, 1, "the line ends before the second vertex of the edge"},
+		{edgeList, "3 2\n1 2\n", 3, 1, "unexpected EOF"},
+		{edgeList, "3 1\n1 2\n2 3\n", 3, 1, `unexpected "2" after 1 edges`},
+		{edgeList, "3 -1\n", 1, 3, "count -1 is not in the range"},
+		{edgeList, "3\n1 2\n", 1, 1, "the line ends before the number of edges"},
+		{weightedEdgeList, "3 1\n1 2\n3", 2, 3, "the line ends before the weight of the edge"},
+		{weightedEdgeList, "3 2\n1 2 5\n2 1 6\n", 3, 5, "edge (2, 1) appears twice"},
+		{adjacencyList, "1 2\n2 1\n1 3\n", 3, 1, "vertex 1 is listed twice"},
+		{adjacencyList, "1 2 3 2\n", 1, 7, "edge (1, 2) appears twice"},
+		{adjacencyList, "1 2\n2 a\n", 2, 3, "invalid syntax"},
+		{dimacs, "c\np edge 2 1\ne 1 2 3\n", 3, 7, `unexpected "3" at the end of the line`},
+		{dimacs, "e 1 2\n", 1, 1, `"e" line before the problem line`},
+		{dimacs, "p edge 2 1\na 1 2 3\n", 2, 1, `"a" line in a edge problem`},
+		{dimacs, "p max 2 1\n", 1, 3, `unknown problem "max"`},
+		{dimacs, "p edge 2 1\ne 1 2\np edge 2 1\n", 3, 1, "there are two problem lines"},
+		{dimacs, "p edge 3 1\ne 1 2\ne 2 3\n", 3, 1, "more than the 1 edges"},
+		{dimacs, "p edge 3 2\ne 1 2\n", 2, 5, "expected 2 edges; got 1"},
+		{dimacs, "p edge 2 1\ne\n1 2\n", 2, 1, "the line ends before the first vertex of the edge"},
+		{dimacs, "p sp 3 2\na 1 2 3\na 2 1 4\n", 3, 7, "arc (2, 1) of weight 4 reverses one of weight 3"},
+		{dimacs, "c only a comment\nx\n", 2, 1, `unknown line "x"`},
+		{dimacs, "p sp 3\n", 1, 6, "the line ends before the number of edges"},
+		{dimacs, "", 1, 1, "there is no problem line"},
+	}
+
+	for i, test := range tests {
+		err := test.read(test.input)
+		var e *input.Error
+		if !errors.As(err, &e) {
+			t.Errorf("Test %d expected an error; got %v", i, err)
+			continue
+		}
+		if e.Line != test.line || e.Column != test.column || !strings.Contains(e.Error(), test.message) {
+			t.Errorf("Test %d expected %q at %d:%d; got %v", i, test.message, test.line, test.column, e)
+		}
+	}
+}
+
+func edgeList(s string) error {
+	_, _, err := ReadEdgeList(strings.NewReader(s))
+	return err
+}
+
+func weightedEdgeList(s string) error {
+	_, err := ReadWeightedEdgeList(strings.NewReader(s))
+	return err
+}
+
+func adjacencyList(s string) error {
+	_, err := ReadAdjacencyList(strings.NewReader(s))
+	return err
+}
+
+func dimacs(s string) error {
+	_, err := ReadDIMACS(strings.NewReader(s))
+	return err
+}
+
+// FuzzRead checks that no input makes a parser panic, and that every
+// error has a position.
+func FuzzRead(f *testing.F) {
+	for _, s := range []string{
+		"5 3\n1 2\n2 3\n4 2\n",
+		"4 3\n1 2 7\n2 3 -1\n1 3 5\n",
+		"1 2 3\n\n2 3\n3\n",
+		"c comment\np sp 3 2\na 1 2 4\na 2 3 1\n",
+	} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		for name, read := range map[string]func(string) error{
+			"edge list": edgeList, "weighted edge list": weightedEdgeList,
+			"adjacency list": adjacencyList, "DIMACS": dimacs,
+		} {
+			err := read(s)
+			var e *input.Error
+			if err != nil && !errors.As(err, &e) {
+				t.Errorf("%s: %v has no position", name, err)
+			}
+			if e != nil && (e.Line < 1 || e.Column < 1) {
+				t.Errorf("%s: %v is not at a position", name, e)
+			}
+		}
+	})
+}
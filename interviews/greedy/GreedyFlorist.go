@@ -0,0 +1,58 @@
+package greedy
+
+// https://www.hackerrank.com/challenges/greedy-florist/problem
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/abucarlo/hackerrank/interviews/input"
+)
+
+// Optimize returns the minimum cost for k customers
+// to buy all the flowers whose prices are given in c.
+func Optimize(k int32, costs[]int32) int32 {
+	sort.Slice(costs, func(i int, j int) bool { return costs[i] > costs[j] })
+	purchases := make([]int32, k)
+	var result int32 = 0
+	var j int32 = 0
+	for _, cost := range costs {
+		result += (purchases[j] + 1) * cost
+		purchases[j]++
+		j++
+		if (j == k) {
+			j = 0
+		}
+	}
+
+	return result
+}
+
+// ReadGreedyFlorist reads HackerRank's input: the number of flowers and
+// of friends, followed by the prices of the flowers.
+func ReadGreedyFlorist(r io.Reader) (int32, []int32, error) {
+	in := input.NewScanner(r)
+	costs := make([]int32, in.Count(100))
+	k := int32(in.Count(100))
+	if in.Err() == nil && k < 1 {
+		in.Errorf("there must be at least 1 friend, not %d", k)
+	}
+	for i := range costs {
+		costs[i] = in.Int32()
+	}
+	if in.Err() != nil {
+		return 0, nil, in.Err()
+	}
+	return k, costs, nil
+}
+
+// SolveGreedyFlorist reads HackerRank's input and writes the minimum cost.
+func SolveGreedyFlorist(r io.Reader, w io.Writer) error {
+	k, costs, err := ReadGreedyFlorist(r)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, Optimize(k, costs))
+	return err
+}
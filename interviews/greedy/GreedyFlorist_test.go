@@ -3,29 +3,10 @@ package greedy
 // https://www.hackerrank.com/challenges/greedy-florist/problem
 
 import (
-	"sort"
+	"strings"
 	"testing"
 )
 
-// Optimize returns the minimum cost for k customers
-// to buy all the flowers whose prices are given in c.
-func Optimize(k int32, costs[]int32) int32 {
-	sort.Slice(costs, func(i int, j int) bool { return costs[i] > costs[j] })
-	purchases := make([]int32, k)
-	var result int32 = 0
-	var j int32 = 0
-	for _, cost := range costs {
-		result += (purchases[j] + 1) * cost
-		purchases[j]++
-		j++
-		if (j == k) {
-			j = 0
-		}
-	}
-
-	return result
-}
-
 func TestGreedyFlorist(t *testing.T) {
 	result00 := Optimize(3, []int32{2, 5, 6})
 	if result00 != 13 {
@@ -35,4 +16,21 @@ func TestGreedyFlorist(t *testing.T) {
 	if result01 != 15 {
 		t.Errorf("got %d, want %d", result00, 5)
 	}
-}
\ No newline at end of file
+}
+
+func TestReadGreedyFloristErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"3 0\n2 5 6\n", "line 1, column 3: there must be at least 1 friend, not 0"},
+		{"0 2000000000", "line 1, column 3: count 2000000000 is not in the range [0, 100]"},
+	}
+
+	for _, test := range tests {
+		_, _, err := ReadGreedyFlorist(strings.NewReader(test.input))
+		if err == nil || err.Error() != test.expected {
+			t.Errorf("%q: expected %q; got %v", test.input, test.expected, err)
+		}
+	}
+}
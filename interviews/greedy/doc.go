@@ -0,0 +1,3 @@
+// Package greedy solves the "Greedy Algorithms" problems
+// of the HackerRank Interview Preparation Kit.
+package greedy
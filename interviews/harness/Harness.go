@@ -0,0 +1,146 @@
+// Package harness runs solvers against HackerRank's test cases, which are
+// checked in as pairs of files named inputNN.txt and outputNN.txt.
+package harness
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// Solver reads a problem in HackerRank's format from standard input,
+// and writes the solution in HackerRank's format to standard output.
+type Solver func(r io.Reader, w io.Writer) error
+
+// Case is a single test case. Output is empty if there is no expected output.
+type Case struct {
+	Name   string
+	Input  string
+	Output string
+}
+
+var inputPattern = regexp.MustCompile(`^input(\d+)\.txt$`)
+
+// Discover pairs every inputNN.txt in dir with the corresponding outputNN.txt.
+func Discover(dir string) ([]Case, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var cases []Case
+	for _, entry := range entries {
+		match := inputPattern.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		c := Case{match[1], filepath.Join(dir, entry.Name()), ""}
+		output := filepath.Join(dir, "output"+match[1]+".txt")
+		if _, err := os.Stat(output); err == nil {
+			c.Output = output
+		} else if !os.IsNotExist(err) {
+			return nil, err
+		}
+		cases = append(cases, c)
+	}
+	sort.Slice(cases, func(i, j int) bool { return cases[i].Name < cases[j].Name })
+	return cases, nil
+}
+
+// Solve runs the solver on the case's input file.
+func (c Case) Solve(solve Solver) (string, error) {
+	f, err := os.Open(c.Input)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	var out bytes.Buffer
+	w := bufio.NewWriter(&out)
+	if err := solve(bufio.NewReaderSize(f, 1<<20), w); err != nil {
+		return "", err
+	}
+	if err := w.Flush(); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+// MaximumDifferences is the most lines Diff will report.
+const MaximumDifferences = 10
+
+// Diff compares two outputs line by line, ignoring trailing whitespace
+// and trailing blank lines. It returns a description of every line that
+// differs, up to MaximumDifferences.
+func Diff(expected, actual string) []string {
+	e, a := splitLines(expected), splitLines(actual)
+	var result []string
+	count := 0
+	for i := range max(len(e), len(a)) {
+		left, right := "<missing>", "<missing>"
+		if i < len(e) {
+			left = fmt.Sprintf("%q", e[i])
+		}
+		if i < len(a) {
+			right = fmt.Sprintf("%q", a[i])
+		}
+		if left == right {
+			continue
+		}
+		count++
+		if count <= MaximumDifferences {
+			result = append(result, fmt.Sprintf("line %d: expected %s, got %s", i+1, left, right))
+		}
+	}
+	if count > MaximumDifferences {
+		result = append(result, fmt.Sprintf("...and %d more", count-MaximumDifferences))
+	}
+	return result
+}
+
+func splitLines(s string) []string {
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight(l, " \t\r")
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// Run runs the solver on every case in dir, each as a subtest. A case
+// without expected output is still solved, then reported as skipped.
+func Run(t *testing.T, dir string, solve Solver) {
+	t.Helper()
+	cases, err := Discover(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cases) == 0 {
+		t.Fatalf("No test cases in %s", dir)
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			actual, err := c.Solve(solve)
+			if err != nil {
+				t.Fatalf("%s: %v", c.Input, err)
+			}
+			if c.Output == "" {
+				t.Skipf("%s has no expected output", c.Input)
+			}
+			expected, err := os.ReadFile(c.Output)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, d := range Diff(string(expected), actual) {
+				t.Errorf("%s: %s", c.Output, d)
+			}
+		})
+	}
+}
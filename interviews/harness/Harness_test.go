@@ -0,0 +1,89 @@
+package harness
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		expected string
+		actual   string
+		diff     []string
+	}{
+		{"1\n2\n", "1\n2", nil},
+		{"1 \r\n2\n\n", "1\n2\n", nil},
+		{"1\n2\n", "1\n3\n", []string{`line 2: expected "2", got "3"`}},
+		{"1\n", "1\n2\n", []string{`line 2: expected <missing>, got "2"`}},
+		{"1\n2\n", "", []string{
+			`line 1: expected "1", got <missing>`,
+			`line 2: expected "2", got <missing>`,
+		}},
+	}
+
+	for i, test := range tests {
+		actual := Diff(test.expected, test.actual)
+		if !slices.Equal(actual, test.diff) {
+			t.Errorf("Test %d expected %q; got %q", i, test.diff, actual)
+		}
+	}
+}
+
+func TestDiffLimit(t *testing.T) {
+	expected := strings.Repeat("0\n", 25)
+	actual := strings.Repeat("1\n", 25)
+	diff := Diff(expected, actual)
+	if len(diff) != MaximumDifferences+1 || diff[MaximumDifferences] != "...and 15 more" {
+		t.Errorf("Expected %d differences and a summary; got %q", MaximumDifferences, diff)
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"input00.txt", "output00.txt", "input01.txt", "output02.txt", "sample.txt"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("1\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cases, err := Discover(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []Case{
+		{"00", filepath.Join(dir, "input00.txt"), filepath.Join(dir, "output00.txt")},
+		{"01", filepath.Join(dir, "input01.txt"), ""},
+	}
+	if !slices.Equal(cases, expected) {
+		t.Errorf("Expected %v; got %v", expected, cases)
+	}
+}
+
+func TestSolve(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "input00.txt")
+	if err := os.WriteFile(input, []byte("2 3\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	add := func(r io.Reader, w io.Writer) error {
+		var a, b int
+		if _, err := fmt.Fscan(r, &a, &b); err != nil {
+			return err
+		}
+		_, err := fmt.Fprintln(w, a+b)
+		return err
+	}
+
+	actual, err := Case{"00", input, ""}.Solve(add)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actual != "5\n" {
+		t.Errorf("Expected 5; got %q", actual)
+	}
+}
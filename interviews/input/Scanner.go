@@ -0,0 +1,199 @@
+// Package input reads HackerRank's standard input, which is always a
+// sequence of integers and words separated by whitespace.
+package input
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Error locates a malformed or missing token. Lines and columns start at 1.
+type Error struct {
+	Line, Column int
+	Err          error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d, column %d: %v", e.Line, e.Column, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Scanner splits its input into whitespace-separated tokens, tracking the
+// position of each one. Like bufio.Scanner, its errors are sticky: once
+// a read fails, every subsequent read returns a zero value, and Err
+// reports the first failure.
+type Scanner struct {
+	r            *bufio.Reader
+	line, column int
+	// The position of the most recent token.
+	tokenLine, tokenColumn int
+	token                  []byte
+	err                    error
+}
+
+func NewScanner(r io.Reader) *Scanner {
+	return &Scanner{r: bufio.NewReaderSize(r, 1<<16), line: 1}
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\r' || b == '\n' || b == '\v' || b == '\f'
+}
+
+func (s *Scanner) fail(err error) {
+	if s.err == nil {
+		// Before the first token, the error is where reading stopped.
+		if s.tokenLine == 0 {
+			s.tokenLine, s.tokenColumn = s.line, s.column+1
+		}
+		s.err = &Error{s.tokenLine, s.tokenColumn, err}
+	}
+}
+
+// skip consumes whitespace, and reports whether another token follows.
+func (s *Scanner) skip() bool {
+	for {
+		b, err := s.r.ReadByte()
+		if err != nil {
+			if err != io.EOF && s.err == nil {
+				s.err = err
+			}
+			return false
+		}
+		if !isSpace(b) {
+			s.r.UnreadByte()
+			return true
+		}
+		if b == '\n' {
+			s.line++
+			s.column = 0
+		} else {
+			s.column++
+		}
+	}
+}
+
+// More reports whether there is another token to read.
+func (s *Scanner) More() bool {
+	return s.err == nil && s.skip()
+}
+
+// EndOfLine reports whether there are no more tokens on the current line.
+// It is true at the end of input.
+func (s *Scanner) EndOfLine() bool {
+	if s.err != nil {
+		return true
+	}
+	for {
+		b, err := s.r.ReadByte()
+		if err != nil {
+			if err != io.EOF {
+				s.err = err
+			}
+			return true
+		}
+		if b == '\n' || !isSpace(b) {
+			s.r.UnreadByte()
+			return b == '\n'
+		}
+		s.column++
+	}
+}
+
+// SkipLine discards the rest of the current line, e.g. a comment.
+func (s *Scanner) SkipLine() {
+	for s.err == nil {
+		b, err := s.r.ReadByte()
+		if err != nil {
+			if err != io.EOF {
+				s.err = err
+			}
+			return
+		}
+		if b == '\n' {
+			s.line++
+			s.column = 0
+			return
+		}
+		s.column++
+	}
+}
+
+// Word returns the next token.
+func (s *Scanner) Word() string {
+	if s.err != nil {
+		return ""
+	}
+	if !s.skip() {
+		s.tokenLine, s.tokenColumn = s.line, s.column+1
+		s.fail(io.ErrUnexpectedEOF)
+		return ""
+	}
+	s.tokenLine, s.tokenColumn = s.line, s.column+1
+	s.token = s.token[:0]
+	for {
+		b, err := s.r.ReadByte()
+		if err != nil {
+			break
+		}
+		if isSpace(b) {
+			s.r.UnreadByte()
+			break
+		}
+		s.column++
+		s.token = append(s.token, b)
+	}
+	return string(s.token)
+}
+
+func (s *Scanner) integer(bits int) int64 {
+	word := s.Word()
+	if s.err != nil {
+		return 0
+	}
+	n, err := strconv.ParseInt(word, 10, bits)
+	if err != nil {
+		s.fail(err)
+		return 0
+	}
+	return n
+}
+
+func (s *Scanner) Int() int {
+	return int(s.integer(strconv.IntSize))
+}
+
+func (s *Scanner) Int32() int32 {
+	return int32(s.integer(32))
+}
+
+func (s *Scanner) Int64() int64 {
+	return s.integer(64)
+}
+
+// Count reads a number of items to follow, which must be no less than
+// 0 and no more than limit. Limiting counts keeps a corrupt input from
+// causing a huge allocation.
+func (s *Scanner) Count(limit int) int {
+	n := s.Int()
+	if s.err == nil && (n < 0 || n > limit) {
+		s.fail(fmt.Errorf("count %d is not in the range [0, %d]", n, limit))
+		return 0
+	}
+	return n
+}
+
+// Errorf records an error at the position of the most recent token.
+// A parser calls this when a token is well-formed but invalid.
+func (s *Scanner) Errorf(format string, a ...any) {
+	s.fail(fmt.Errorf(format, a...))
+}
+
+// Err returns the first error the Scanner encountered.
+func (s *Scanner) Err() error {
+	return s.err
+}
@@ -0,0 +1,107 @@
+package input
+
+import (
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestScanner(t *testing.T) {
+	s := NewScanner(strings.NewReader("3 -4\n  abc\r\n\n9223372036854775807\n"))
+	if n := s.Int(); n != 3 {
+		t.Errorf("Expected 3; got %d", n)
+	}
+	if n := s.Int32(); n != -4 {
+		t.Errorf("Expected -4; got %d", n)
+	}
+	if w := s.Word(); w != "abc" {
+		t.Errorf("Expected abc; got %q", w)
+	}
+	if n := s.Int64(); n != 9223372036854775807 {
+		t.Errorf("Expected 9223372036854775807; got %d", n)
+	}
+	if s.More() {
+		t.Errorf("Expected the end of input")
+	}
+	if s.Err() != nil {
+		t.Errorf("Expected no error; got %v", s.Err())
+	}
+}
+
+func TestScannerErrors(t *testing.T) {
+	tests := []struct {
+		input        string
+		read         func(s *Scanner)
+		line, column int
+		err          error
+	}{
+		{"1 2\n3 x 5", func(s *Scanner) {
+			for range 5 {
+				s.Int()
+			}
+		}, 2, 3, strconv.ErrSyntax},
+		{"1\n", func(s *Scanner) { s.Int(); s.Int() }, 2, 1, io.ErrUnexpectedEOF},
+		{"  3000000000", func(s *Scanner) { s.Int32() }, 1, 3, strconv.ErrRange},
+		{"2\n-1", func(s *Scanner) { s.Int(); s.Count(10) }, 2, 1, nil},
+	}
+
+	for i, test := range tests {
+		s := NewScanner(strings.NewReader(test.input))
+		test.read(s)
+		var e *Error
+		if !errors.As(s.Err(), &e) {
+			t.Errorf("Test %d expected an error; got %v", i, s.Err())
+			continue
+		}
+		if e.Line != test.line || e.Column != test.column {
+			t.Errorf("Test %d expected an error at %d:%d; got %v", i, test.line, test.column, e)
+		}
+		if test.err != nil && !errors.Is(e, test.err) {
+			t.Errorf("Test %d expected %v; got %v", i, test.err, e)
+		}
+	}
+}
+
+func TestStickyError(t *testing.T) {
+	s := NewScanner(strings.NewReader("x 1 2"))
+	s.Int()
+	if n := s.Int(); n != 0 {
+		t.Errorf("Expected 0 after an error; got %d", n)
+	}
+	if s.Err().Error() != `line 1, column 1: strconv.ParseInt: parsing "x": invalid syntax` {
+		t.Errorf("Expected the first error; got %v", s.Err())
+	}
+}
+
+func TestLines(t *testing.T) {
+	s := NewScanner(strings.NewReader("c a comment\n1 2 \r\n\n3\n  c\tcomment"))
+	if s.Word() != "c" {
+		t.Fatal("Expected a comment")
+	}
+	s.SkipLine()
+	if n := s.Int(); n != 1 || s.EndOfLine() {
+		t.Errorf("Expected 1 with more on the line; got %d", n)
+	}
+	if n := s.Int(); n != 2 || !s.EndOfLine() {
+		t.Errorf("Expected 2 at the end of the line; got %d", n)
+	}
+	if n := s.Int(); n != 3 || !s.EndOfLine() {
+		t.Errorf("Expected 3 at the end of the line; got %d", n)
+	}
+	s.Word()
+	s.SkipLine()
+	if s.More() || !s.EndOfLine() || s.Err() != nil {
+		t.Errorf("Expected the end of input; got %v", s.Err())
+	}
+
+	s = NewScanner(strings.NewReader("1\n2 x"))
+	s.Int()
+	s.SkipLine()
+	s.Int()
+	s.Int()
+	if s.Err() == nil || !strings.HasPrefix(s.Err().Error(), "line 2, column 3:") {
+		t.Errorf("Expected an error at line 2, column 3; got %v", s.Err())
+	}
+}
@@ -0,0 +1,44 @@
+package miscellaneous
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/abucarlo/hackerrank/interviews/input"
+)
+
+// https://www.hackerrank.com/challenges/flipping-bits/problem
+
+// FlippingBits inverts the 32 low-order bits of n.
+func FlippingBits(n int64) int64 {
+	low := uint32(n)
+	return int64(^low)
+}
+
+// ReadFlippingBits reads HackerRank's input: the number
+// of queries, followed by one integer per line.
+func ReadFlippingBits(r io.Reader) ([]int64, error) {
+	in := input.NewScanner(r)
+	queries := make([]int64, in.Count(100))
+	for i := range queries {
+		queries[i] = in.Int64()
+	}
+	if in.Err() != nil {
+		return nil, in.Err()
+	}
+	return queries, nil
+}
+
+// SolveFlippingBits reads HackerRank's input and writes each integer with its bits flipped.
+func SolveFlippingBits(r io.Reader, w io.Writer) error {
+	queries, err := ReadFlippingBits(r)
+	if err != nil {
+		return err
+	}
+	for _, n := range queries {
+		if _, err := fmt.Fprintln(w, FlippingBits(n)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package miscellaneous
+
+/*
+	https://www.hackerrank.com/challenges/friend-circle-queries/problem
+
+	This is a graph problem: find the largest disjoint set.
+*/
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/abucarlo/hackerrank/interviews/graphs"
+	"github.com/abucarlo/hackerrank/interviews/input"
+)
+
+// FriendCircle returns, after each query joins two people as friends,
+// the size of the largest circle of friends.
+func FriendCircle(queries [][]int) []int {
+	friendship := graphs.NewUnionFind[int]()
+	max := 0
+	result := make([]int, len(queries))
+	for i, q := range queries {
+		left, right := q[0], q[1]
+		friendship.Union(left, right)
+		if size := friendship.ComponentSize(left); size > max {
+			max = size
+		}
+		result[i] = max
+	}
+	return result
+}
+
+// ReadFriendCircle reads HackerRank's input: the number of queries,
+// then one pair of friends per line.
+func ReadFriendCircle(r io.Reader) ([][]int, error) {
+	in := input.NewScanner(r)
+	queries := make([][]int, in.Count(100000))
+	for i := range queries {
+		queries[i] = []int{in.Int(), in.Int()}
+	}
+	if in.Err() != nil {
+		return nil, in.Err()
+	}
+	return queries, nil
+}
+
+// SolveFriendCircle reads HackerRank's input and writes the size
+// of the largest circle after each query.
+func SolveFriendCircle(r io.Reader, w io.Writer) error {
+	queries, err := ReadFriendCircle(r)
+	if err != nil {
+		return err
+	}
+	for _, size := range FriendCircle(queries) {
+		if _, err := fmt.Fprintln(w, size); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,80 +1,14 @@
 package miscellaneous
 
-/*
-	https://www.hackerrank.com/challenges/friend-circle-queries/problem
-
-	This is a graph problem: find the largest disjoint set.
-*/
+// https://www.hackerrank.com/challenges/friend-circle-queries/problem
 
 import (
-	"bufio"
-	"fmt"
 	"os"
 	"slices"
-	"strconv"
-	"strings"
 	"testing"
-)
-
-func FriendCircle(queries [][]int) []int {
-	friendship := map[int]map[int]bool{}
-	max := 0
-	result := make([]int, len(queries))
-	for i, q := range queries {
-		// Is one of these nodes already in the map?
-		left, right := q[0], q[1]
-		// Map length is stored, so this is cheap.
-		if len(friendship[left]) < len(friendship[right]) {
-			left, right = right, left
-		}
-		// If left is new, right must also be new.
-		if _, l := friendship[left]; !l {
-			// This turns out to be more efficient, since most of these
-			// maps are combined into larger ones. Allocating larger
-			// ones turns out to waste time.
-			m := map[int]bool{ left: true, right: true}
-			friendship[left] = m
- 			friendship[right] = m
-		} else if _, r := friendship[right]; !r {
-			// right is new; add it to left's friends.
-			friendship[left][right] = true
-			friendship[right] = friendship[left]
-		} else {
-			// Copy right's friends to left.
-			for friend := range friendship[right] {
-				friendship[left][friend] = true
-			}
-			// Every friend shares the same map.
-			for friend := range friendship[left] {
-				friendship[friend] = friendship[left]
-			}
-		}
-		if len(friendship[left]) > max {
-			max = len(friendship[left])
-		}
-		result[i] = max
-	}
-	return result
-}
-
-func readEdges(f *os.File) [][]int {
-	scanner := bufio.NewScanner(f)
-	scanner.Scan()
-	size, _ := strconv.Atoi(scanner.Text())
-	edges := make([][]int, 0, size)
-	for scanner.Scan() {
-		edge := strings.Split(scanner.Text(), " ")
-		right, _ := strconv.Atoi(edge[0])
-		left, _ := strconv.Atoi(edge[0])
-		edges = append(edges, []int{left, right})
-	}
 
-	if len(edges) != size {
-		panic(fmt.Sprintf("Expected inputs of size %d; got %d", size, len(edges)))
-	}
-
-	return edges
-}
+	"github.com/abucarlo/hackerrank/interviews/harness"
+)
 
 func BenchmarkFriendCircle(b *testing.B) {
 	inputFileName := "friend-circle/input10.txt"
@@ -84,7 +18,10 @@ func BenchmarkFriendCircle(b *testing.B) {
 		b.Fatal(err)
 	}
 	defer inputFile.Close()
-	edges := readEdges(inputFile)
+	edges, err := ReadFriendCircle(inputFile)
+	if err != nil {
+		b.Fatal(err)
+	}
 
 	b.Run("Test Case 10", func(b *testing.B) {
 		for i := 0; i < b.N; i++ {
@@ -93,6 +30,10 @@ func BenchmarkFriendCircle(b *testing.B) {
 	})
 }
 
+func TestFriendCircleFiles(t *testing.T) {
+	harness.Run(t, "friend-circle", SolveFriendCircle)
+}
+
 func TestFriendCircle(t *testing.T) {
 
 	type Table struct {
@@ -138,4 +79,4 @@ func TestFriendCircle(t *testing.T) {
 			t.Logf("Test %d returns %v", i, output)
 		}
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,110 @@
+package miscellaneous
+
+// https://www.hackerrank.com/challenges/maximum-xor/problem
+
+import (
+	"fmt"
+	"io"
+	"math/bits"
+
+	"github.com/abucarlo/hackerrank/interviews/input"
+	"golang.org/x/exp/constraints"
+)
+
+// MaxXorArray returns the maximum XOR of any two values in a.
+func MaxXorArray(a []int32) int32 {
+	max := int32(0)
+	mask := int32(0)
+
+    startPosition := 0
+    for _, n := range a {
+        highestOneBit := 31 - bits.LeadingZeros32(uint32(n))
+        if highestOneBit > startPosition {
+            startPosition = highestOneBit
+        }
+    }
+
+    // Start with the highest-order bit, and end with 0b1.
+	for position := startPosition; position >= 0; position -= 1 {
+		bit := int32(1 << position)
+        // Add the next-highest-order bit to the mask.
+		mask |= bit
+
+		set := map[int32]bool{}
+        // Find all possible prefixes with respect to the current mask.
+		for _, num := range a {
+			left := num & mask
+			set[left] = true
+		}
+        // Try to find a value better than the current maximum,
+        // i.e. with one more bit. Any value with a 0 bit in the 
+        // current position is, as far as we know, no better than
+        // max, since we can't peek ahead at bits to the right.
+		greed := max | bit
+
+		for prefix := range set {
+            // This is the crucial step: 
+            // greed ^ prefix *also* has
+            // to be an available prefix,
+            // i.e. there are two values
+            // with different prefixes
+            // whose XOR gives us a new maximum.
+			if set[greed^prefix] {
+				max = greed
+				break
+			}
+		}
+	}
+	return max
+}
+
+// MaxXor returns, for every query, its maximum XOR with any value in arr.
+func MaxXor[N constraints.Integer](arr []N, queries []N) []N {
+	// Amazingly, the way to solve this on HackerRank is 
+	// to avoid this allocation, and write the results 
+	// into queries!
+	result := make([]N, len(queries))
+	for i, q := range queries {
+		result[i] = N(0)
+		for _, a := range arr {
+			x := q ^ a
+			if x > result[i] {
+				result[i] = x
+			}
+		}
+	}
+
+	return result
+}
+
+// ReadMaxXor reads HackerRank's input: the array and
+// then the queries, each preceded by its length.
+func ReadMaxXor(r io.Reader) ([]int64, []int64, error) {
+	in := input.NewScanner(r)
+	arr := make([]int64, in.Count(100000))
+	for i := range arr {
+		arr[i] = in.Int64()
+	}
+	queries := make([]int64, in.Count(100000))
+	for i := range queries {
+		queries[i] = in.Int64()
+	}
+	if in.Err() != nil {
+		return nil, nil, in.Err()
+	}
+	return arr, queries, nil
+}
+
+// SolveMaxXor reads HackerRank's input and writes the answer to every query.
+func SolveMaxXor(r io.Reader, w io.Writer) error {
+	arr, queries, err := ReadMaxXor(r)
+	if err != nil {
+		return err
+	}
+	for _, x := range MaxXor(arr, queries) {
+		if _, err := fmt.Fprintln(w, x); err != nil {
+			return err
+		}
+	}
+	return nil
+}
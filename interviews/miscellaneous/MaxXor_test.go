@@ -3,87 +3,20 @@ package miscellaneous
 // https://www.hackerrank.com/challenges/maximum-xor/problem
 
 import (
-	"math/bits"
 	"slices"
 	"testing"
-
-	"golang.org/x/exp/constraints"
 )
 
-func maxXorArray(a []int32) int32 {
-	max := int32(0)
-	mask := int32(0)
-
-    startPosition := 0
-    for _, n := range a {
-        highestOneBit := 31 - bits.LeadingZeros32(uint32(n))
-        if highestOneBit > startPosition {
-            startPosition = highestOneBit
-        }
-    }
-
-    // Start with the highest-order bit, and end with 0b1.
-	for position := startPosition; position >= 0; position -= 1 {
-		bit := int32(1 << position)
-        // Add the next-highest-order bit to the mask.
-		mask |= bit
-
-		set := map[int32]bool{}
-        // Find all possible prefixes with respect to the current mask.
-		for _, num := range a {
-			left := num & mask
-			set[left] = true
-		}
-        // Try to find a value better than the current maximum,
-        // i.e. with one more bit. Any value with a 0 bit in the 
-        // current position is, as far as we know, no better than
-        // max, since we can't peek ahead at bits to the right.
-		greed := max | bit
-
-		for prefix := range set {
-            // This is the crucial step: 
-            // greed ^ prefix *also* has
-            // to be an available prefix,
-            // i.e. there are two values
-            // with different prefixes
-            // whose XOR gives us a new maximum.
-			if set[greed^prefix] {
-				max = greed
-				break
-			}
-		}
-	}
-	return max
-}
-
 func TestMaxXorArray(t *testing.T) {
 	// https://stackoverflow.com/a/66822115/476942
 	sample := []int32{3, 10, 5, 25, 2, 8}
-	actual := maxXorArray(sample)
+	actual := MaxXorArray(sample)
 	if actual != 28 {
 		t.Errorf("Expected %d for %v; actual %v", 28, sample, actual)
 
 	}
 }
 
-func maxXor[N constraints.Integer](arr []N, queries []N) []N {
-	// Amazingly, the way to solve this on HackerRank is 
-	// to avoid this allocation, and write the results 
-	// into queries!
-	result := make([]N, len(queries))
-	for i, q := range queries {
-		result[i] = N(0)
-		for _, a := range arr {
-			x := q ^ a
-			if x > result[i] {
-				result[i] = x
-			}
-		}
-	}
-
-	return result
-}
-
 func TestSamples(t *testing.T) {
 	tests := []struct {
 		arr      []int
@@ -108,7 +41,7 @@ func TestSamples(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		actual := maxXor(test.arr, test.queries)
+		actual := MaxXor(test.arr, test.queries)
 		if !slices.Equal(actual, test.expected) {
 			t.Errorf("Test # %d expected %v; actual %v", i, test.expected, actual)
 		} else {
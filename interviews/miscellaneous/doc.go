@@ -0,0 +1,3 @@
+// Package miscellaneous solves the "Miscellaneous" problems
+// of the HackerRank Interview Preparation Kit.
+package miscellaneous
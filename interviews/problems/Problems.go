@@ -0,0 +1,160 @@
+package problems
+
+import (
+	"github.com/abucarlo/hackerrank/interviews/dictionaries"
+	"github.com/abucarlo/hackerrank/interviews/dynamicprogramming"
+	"github.com/abucarlo/hackerrank/interviews/graphs"
+	"github.com/abucarlo/hackerrank/interviews/greedy"
+	"github.com/abucarlo/hackerrank/interviews/miscellaneous"
+	"github.com/abucarlo/hackerrank/interviews/recursion"
+	"github.com/abucarlo/hackerrank/interviews/search"
+	"github.com/abucarlo/hackerrank/interviews/trees"
+)
+
+func init() {
+	Register(Problem{
+		Slug:       "two-strings",
+		Title:      "Two Strings",
+		Category:   Dictionaries,
+		Complexity: "O(|s| + |t|)",
+	}, dictionaries.SolveTwoStrings)
+
+	Register(Problem{
+		Slug:       "abbr",
+		Title:      "Abbreviation",
+		Category:   DynamicProgramming,
+		Complexity: "O(|a|·|b|)",
+		Fixtures:   "dynamicprogramming/abbreviation-inputs",
+	}, dynamicprogramming.SolveAbbreviation)
+
+	Register(Problem{
+		Slug:       "decibinary-numbers",
+		Title:      "Decibinary Numbers",
+		Category:   DynamicProgramming,
+		Complexity: "O(log n) per query, after counting the numerals of every decimal value",
+		Fixtures:   "dynamicprogramming/decibinary",
+	}, dynamicprogramming.SolveDecibinaryNumbers)
+
+	Register(Problem{
+		Slug:       "torque-and-development",
+		Title:      "Roads and Libraries",
+		Category:   Graphs,
+		Complexity: "O(n log n + m)",
+	}, graphs.SolveRoadsAndLibraries)
+
+	Register(Problem{
+		Slug:       "matrix",
+		Title:      "Matrix",
+		Category:   Graphs,
+		Complexity: "O(n log n)",
+		Fixtures:   "graphs/matrix-inputs",
+	}, graphs.SolveMatrix)
+
+	Register(Problem{
+		Slug:       "bfs-shortest-reach",
+		Title:      "BFS: Shortest Reach in a Graph",
+		Category:   Graphs,
+		Complexity: "O(n + m) per query",
+	}, graphs.SolveShortestReach)
+
+	Register(Problem{
+		Slug:       "castle-on-the-grid",
+		Title:      "Castle on the Grid",
+		Category:   Graphs,
+		Complexity: "O(n²)",
+	}, graphs.SolveCastle)
+
+	Register(Problem{
+		Slug:       "find-the-nearest-clone",
+		Title:      "Find the nearest clone",
+		Category:   Graphs,
+		Complexity: "O(n + m)",
+		Fixtures:   "graphs/find-clone-inputs",
+	}, graphs.SolveFindClone)
+
+	Register(Problem{
+		Slug:       "ctci-connected-cell-in-a-grid",
+		Title:      "DFS: Connected Cell in a Grid",
+		Category:   Graphs,
+		Complexity: "O(n·m)",
+	}, graphs.SolveMaxRegion)
+
+	Register(Problem{
+		Slug:       "greedy-florist",
+		Title:      "Greedy Florist",
+		Category:   Greedy,
+		Complexity: "O(n log n)",
+	}, greedy.SolveGreedyFlorist)
+
+	Register(Problem{
+		Slug:       "flipping-bits",
+		Title:      "Flipping bits",
+		Category:   Miscellaneous,
+		Complexity: "O(1) per query",
+	}, miscellaneous.SolveFlippingBits)
+
+	Register(Problem{
+		Slug:       "friend-circle-queries",
+		Title:      "Friend Circle Queries",
+		Category:   Miscellaneous,
+		Complexity: "O(q·α(q))",
+		Fixtures:   "miscellaneous/friend-circle",
+	}, miscellaneous.SolveFriendCircle)
+
+	Register(Problem{
+		Slug:       "maximum-xor",
+		Title:      "Maximum Xor",
+		Category:   Miscellaneous,
+		Complexity: "O(n·m)",
+	}, miscellaneous.SolveMaxXor)
+
+	Register(Problem{
+		Slug:       "crossword-puzzle",
+		Title:      "Crossword Puzzle",
+		Category:   Recursion,
+		Complexity: "O(w!) for w words, by backtracking",
+	}, recursion.SolveCrossword)
+
+	Register(Problem{
+		Slug:       "ctci-recursive-staircase",
+		Title:      "Recursion: Davis' Staircase",
+		Category:   Recursion,
+		Complexity: "O(n)",
+	}, recursion.SolveDavisStaircase)
+
+	Register(Problem{
+		Slug:       "ctci-fibonacci-numbers",
+		Title:      "Recursion: Fibonacci Numbers",
+		Category:   Recursion,
+		Complexity: "O(n)",
+	}, recursion.SolveFibonacci)
+
+	Register(Problem{
+		Slug:       "recursive-digit-sum",
+		Title:      "Recursive Digit Sum",
+		Category:   Recursion,
+		Complexity: "O(|n| + k)",
+	}, recursion.SolveSuperDigit)
+
+	Register(Problem{
+		Slug:       "ctci-ice-cream-parlor",
+		Title:      "Hash Tables: Ice Cream Parlor",
+		Category:   Search,
+		Complexity: "O(n log n) per trip",
+	}, search.SolveIceCreamParlor)
+
+	Register(Problem{
+		Slug:       "making-candies",
+		Title:      "Making Candies",
+		Category:   Search,
+		Complexity: "O(log n)",
+	}, search.SolveMakingCandies)
+
+	Register(Problem{
+		Slug:       "balanced-forest",
+		Title:      "Balanced Forest",
+		Category:   Trees,
+		Complexity: "O(n log n + n·h) per query, for a tree of height h",
+		Fixtures:   "trees/balanced-forest-inputs",
+	}, trees.SolveBalancedForest)
+}
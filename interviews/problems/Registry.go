@@ -0,0 +1,101 @@
+// Package problems is a registry of every HackerRank problem solved in this
+// repository, with the function that solves each one.
+package problems
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// Category is a section of the Interview Preparation Kit, named for the
+// package that holds its solutions.
+type Category string
+
+const (
+	Dictionaries       Category = "dictionaries"
+	DynamicProgramming Category = "dynamicprogramming"
+	Graphs             Category = "graphs"
+	Greedy             Category = "greedy"
+	Miscellaneous      Category = "miscellaneous"
+	Recursion          Category = "recursion"
+	Search             Category = "search"
+	Trees              Category = "trees"
+)
+
+// Problem describes a solved problem and the function that solves it.
+type Problem struct {
+	// Slug is the problem's name in its URL, e.g. "balanced-forest".
+	Slug     string
+	Title    string
+	Category Category
+	// Complexity is the running time of the solution, in big-O notation.
+	Complexity string
+	// Fixtures is the directory, relative to the interviews directory,
+	// holding HackerRank's test cases, if any are checked in.
+	Fixtures string
+
+	solve func(io.Reader, io.Writer) error
+}
+
+const prefix = "https://www.hackerrank.com/challenges/"
+
+func (p *Problem) URL() string {
+	return prefix + p.Slug + "/problem"
+}
+
+// Run reads the input, solves it, and writes the output, all in
+// HackerRank's format.
+func (p *Problem) Run(r io.Reader, w io.Writer) error {
+	return p.solve(r, w)
+}
+
+var registry = make(map[string]*Problem)
+
+// Register adds a problem to the registry, with the function that reads
+// its input, solves it and writes its output. It panics if the slug is
+// already registered.
+func Register(p Problem, solve func(io.Reader, io.Writer) error) {
+	if _, ok := registry[p.Slug]; ok {
+		panic(fmt.Sprintf("problem %q is already registered", p.Slug))
+	}
+	p.solve = solve
+	registry[p.Slug] = &p
+}
+
+// Lookup finds a problem by its slug.
+func Lookup(slug string) (*Problem, bool) {
+	p, ok := registry[slug]
+	return p, ok
+}
+
+// LookupURL finds a problem by any URL of its HackerRank page, e.g.
+// https://www.hackerrank.com/challenges/abbr/problem?isFullScreen=true
+func LookupURL(address string) (*Problem, bool) {
+	u, err := url.Parse(address)
+	if err != nil || u.Host != "hackerrank.com" && !strings.HasSuffix(u.Host, ".hackerrank.com") {
+		return nil, false
+	}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(segments) < 2 || segments[0] != "challenges" {
+		return nil, false
+	}
+	return Lookup(segments[1])
+}
+
+// All returns every problem, ordered by slug.
+func All() []*Problem {
+	result := make([]*Problem, 0, len(registry))
+	for _, p := range registry {
+		result = append(result, p)
+	}
+	slices.SortFunc(result, func(a, b *Problem) int { return strings.Compare(a.Slug, b.Slug) })
+	return result
+}
+
+// InCategory returns every problem in the category, ordered by slug.
+func InCategory(c Category) []*Problem {
+	return slices.DeleteFunc(All(), func(p *Problem) bool { return p.Category != c })
+}
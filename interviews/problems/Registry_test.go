@@ -0,0 +1,90 @@
+package problems
+
+import (
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/abucarlo/hackerrank/interviews/harness"
+)
+
+func TestMetadata(t *testing.T) {
+	categories := map[Category]bool{
+		Dictionaries: true, DynamicProgramming: true, Graphs: true, Greedy: true,
+		Miscellaneous: true, Recursion: true, Search: true, Trees: true,
+	}
+	for _, p := range All() {
+		if p.Title == "" || p.Complexity == "" || !categories[p.Category] {
+			t.Errorf("%s is missing metadata: %+v", p.Slug, p)
+		}
+		if _, err := url.Parse(p.URL()); err != nil {
+			t.Errorf("%s has an invalid URL: %v", p.Slug, err)
+		}
+	}
+}
+
+func TestLookupURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		slug string
+	}{
+		{"https://www.hackerrank.com/challenges/balanced-forest/problem", "balanced-forest"},
+		{"http://hackerrank.com/challenges/abbr/problem?isFullScreen=true", "abbr"},
+		{"https://www.hackerrank.com/challenges/torque-and-development", "torque-and-development"},
+		{"https://www.hackerrank.com/challenges/no-such-problem/problem", ""},
+		{"https://www.example.com/challenges/abbr/problem", ""},
+		{"https://evilhackerrank.com/challenges/abbr/problem", ""},
+		{"balanced-forest", ""},
+	}
+
+	for _, test := range tests {
+		p, ok := LookupURL(test.url)
+		if ok != (test.slug != "") || (ok && p.Slug != test.slug) {
+			t.Errorf("%s should find %q; got %v", test.url, test.slug, p)
+		}
+	}
+
+	for _, p := range All() {
+		if q, ok := LookupURL(p.URL()); !ok || q != p {
+			t.Errorf("%s should be found by its own URL", p.Slug)
+		}
+	}
+}
+
+func TestInCategory(t *testing.T) {
+	total := 0
+	for _, c := range []Category{Dictionaries, DynamicProgramming, Graphs, Greedy, Miscellaneous, Recursion, Search, Trees} {
+		problems := InCategory(c)
+		if len(problems) == 0 {
+			t.Errorf("There should be problems in %s", c)
+		}
+		total += len(problems)
+	}
+	if total != len(All()) {
+		t.Errorf("Expected %d problems in all categories; got %d", len(All()), total)
+	}
+}
+
+func TestSamples(t *testing.T) {
+	p, _ := Lookup("torque-and-development")
+	var out strings.Builder
+	if err := p.Run(strings.NewReader("2\n3 3 2 1\n1 2\n3 1\n2 3\n6 6 2 5\n1 3\n3 4\n2 4\n1 2\n2 3\n5 6\n"), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "4\n12\n" {
+		t.Errorf("Expected 4 and 12; got %q", out.String())
+	}
+}
+
+// TestFixtures runs every registered problem against its checked-in test cases.
+func TestFixtures(t *testing.T) {
+	for _, p := range All() {
+		if p.Fixtures == "" {
+			continue
+		}
+		t.Run(p.Slug, func(t *testing.T) {
+			harness.Run(t, filepath.Join("..", p.Fixtures), p.Run)
+		})
+	}
+}
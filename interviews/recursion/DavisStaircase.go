@@ -0,0 +1,57 @@
+package recursion
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/abucarlo/hackerrank/interviews/input"
+)
+
+// https://www.hackerrank.com/challenges/ctci-recursive-staircase/problem
+
+const Modulus int64 = 10000000007
+
+// Climb counts the ways to climb n steps, taking 1, 2 or 3 at a time.
+func Climb(n int32) int32 {
+	strides := []int32{1, 2, 3}
+	ways := make([]int64, n+1)
+	// There is 1 way to get to the 0th step, i.e. do nothing.
+	ways[0] = 1
+	for i := int32(1); i < n+1; i++ {
+		for _, s := range strides {
+			if i-s >= 0 {
+				ways[i] += ways[i-s]
+			}
+		}
+		ways[i] %= Modulus
+	}
+	return int32(ways[n])
+}
+
+// ReadDavisStaircase reads HackerRank's input: the number of
+// staircases, followed by the height of each one.
+func ReadDavisStaircase(r io.Reader) ([]int32, error) {
+	in := input.NewScanner(r)
+	heights := make([]int32, in.Count(5))
+	for i := range heights {
+		heights[i] = int32(in.Count(36))
+	}
+	if in.Err() != nil {
+		return nil, in.Err()
+	}
+	return heights, nil
+}
+
+// SolveDavisStaircase reads HackerRank's input and writes the ways to climb each staircase.
+func SolveDavisStaircase(r io.Reader, w io.Writer) error {
+	heights, err := ReadDavisStaircase(r)
+	if err != nil {
+		return err
+	}
+	for _, n := range heights {
+		if _, err := fmt.Fprintln(w, Climb(n)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
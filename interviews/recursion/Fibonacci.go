@@ -0,0 +1,43 @@
+package recursion
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/abucarlo/hackerrank/interviews/input"
+)
+
+// https://www.hackerrank.com/challenges/ctci-fibonacci-numbers/problem
+
+var m = map[int]int{
+	0: 0,
+	1: 1,
+}
+
+// Fibonacci returns the nth Fibonacci number, memoizing every one along the way.
+func Fibonacci(n int) int {
+	if f, has := m[n]; has {
+		return f
+	} else {
+		f = Fibonacci(n-1) + Fibonacci(n-2)
+		m[n] = f
+		return f
+	}
+}
+
+// ReadFibonacci reads n from HackerRank's input.
+func ReadFibonacci(r io.Reader) (int, error) {
+	in := input.NewScanner(r)
+	n := in.Count(30)
+	return n, in.Err()
+}
+
+// SolveFibonacci reads n from HackerRank's input and writes the nth Fibonacci number.
+func SolveFibonacci(r io.Reader, w io.Writer) error {
+	n, err := ReadFibonacci(r)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, Fibonacci(n))
+	return err
+}
@@ -0,0 +1,55 @@
+package recursion
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/abucarlo/hackerrank/interviews/input"
+)
+
+// https://www.hackerrank.com/challenges/recursive-digit-sum/problem
+
+// SuperDigit really does not need recursion.
+func SuperDigit(n string, k int32) int32 {
+	var d int32 = 0
+	for _, c := range n {
+		d += int32(c) - int32('0')
+		if d > 9 {
+			d = d/10 + d%10
+		}
+	}
+	var result int32 = 0
+	for i := int32(0); i < k; i++ {
+		result += d
+		if result > 9 {
+			result = result/10 + result%10
+		}
+	}
+	return result
+}
+
+// ReadSuperDigit reads HackerRank's input: a string of digits,
+// and the number of times to repeat it.
+func ReadSuperDigit(r io.Reader) (string, int32, error) {
+	in := input.NewScanner(r)
+	n := in.Word()
+	if in.Err() == nil && strings.Trim(n, "0123456789") != "" {
+		in.Errorf("%q is not a string of digits", n)
+	}
+	k := int32(in.Count(100000))
+	if in.Err() != nil {
+		return "", 0, in.Err()
+	}
+	return n, k, nil
+}
+
+// SolveSuperDigit reads HackerRank's input and writes the super digit.
+func SolveSuperDigit(r io.Reader, w io.Writer) error {
+	n, k, err := ReadSuperDigit(r)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, SuperDigit(n, k))
+	return err
+}
@@ -0,0 +1,3 @@
+// Package recursion solves the "Recursion and Backtracking" problems
+// of the HackerRank Interview Preparation Kit.
+package recursion
@@ -0,0 +1,89 @@
+package search
+
+// https://www.hackerrank.com/challenges/ctci-ice-cream-parlor/problem
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/abucarlo/hackerrank/interviews/input"
+)
+
+// FindPair returns the indices of the values
+// adding up to "money"
+func FindPair(cost []int32, money int32) (int, int) {
+	type element struct {
+		value int32
+		index int
+	}
+	// Index the values by original location.
+	var indexed = []element{}
+
+	for i, value := range cost {
+		indexed = append(indexed, element{value, i})
+	}
+
+	// Sort descending, because of how binary search in Go works.
+	sort.Slice(indexed, func(i int, j int) bool { return indexed[i].value > indexed[j].value })
+	var high, low int
+	var max int32 = 0
+
+	// TODO Start with money.
+	for i, hi := range indexed {
+		j := sort.Search(len(indexed), func(j int) bool { return j > i && hi.value+indexed[j].value <= money })
+		if j == len(indexed) {
+			continue
+		}
+		lo := indexed[j]
+		if hi.value+indexed[j].value > max {
+			high = hi.index
+			low = lo.index
+			max = hi.value + lo.value
+		}
+	}
+	if low < high {
+		return low, high
+	} // else
+	return high, low
+}
+
+// Trip is a single trip to the ice cream parlor.
+type Trip struct {
+	Money int32
+	Cost  []int32
+}
+
+// ReadIceCreamParlor reads HackerRank's input: the number of trips, then
+// for each one the money, the number of flavors, and their costs.
+func ReadIceCreamParlor(r io.Reader) ([]Trip, error) {
+	in := input.NewScanner(r)
+	trips := make([]Trip, in.Count(50))
+	for i := range trips {
+		trips[i].Money = in.Int32()
+		trips[i].Cost = make([]int32, in.Count(50000))
+		for j := range trips[i].Cost {
+			trips[i].Cost[j] = in.Int32()
+		}
+	}
+	if in.Err() != nil {
+		return nil, in.Err()
+	}
+	return trips, nil
+}
+
+// SolveIceCreamParlor reads HackerRank's input and writes the 1-based
+// indices of the two flavors bought on every trip.
+func SolveIceCreamParlor(r io.Reader, w io.Writer) error {
+	trips, err := ReadIceCreamParlor(r)
+	if err != nil {
+		return err
+	}
+	for _, t := range trips {
+		i, j := FindPair(t.Cost, t.Money)
+		if _, err := fmt.Fprintln(w, i+1, j+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package search
+
+// https://www.hackerrank.com/challenges/making-candies/problem
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/abucarlo/hackerrank/interviews/input"
+)
+
+// MinimumPasses returns the fewest passes needed to make target candies,
+// starting with the given numbers of machines and workers, where another
+// machine or worker costs price candies.
+func MinimumPasses(machines, workers, price, target int) int {
+	if target <= price {
+		return int(math.Ceil(float64(target) / float64(machines*workers)))
+	}
+
+	candies := 0
+	iterations := 0
+	current := math.MaxInt
+
+	for candies < target {
+		if candies < price {
+			i := int(math.Ceil(float64(price-candies) / float64(machines*workers)))
+			iterations += i
+			candies += machines * workers * i
+			continue
+		}
+
+		purchased := candies/price
+		candies = candies%price
+		assets := machines + workers + purchased
+		half := assets / 2
+
+		if machines > workers {
+			machines = max(machines, half)
+			workers = assets - machines
+		} else {
+			workers = max(workers, half)
+			machines = assets - workers
+		}
+
+		iterations += 1
+		candies += machines * workers
+		current = min(current, iterations+int(math.Ceil(float64(target-candies)/float64(machines*workers))))
+	}
+
+	return min(current, iterations)
+}
+
+// ReadMakingCandies reads HackerRank's input: the machines,
+// workers, price and target, all on one line.
+func ReadMakingCandies(r io.Reader) ([]int, error) {
+	in := input.NewScanner(r)
+	inputs := []int{in.Int(), in.Int(), in.Int(), in.Int()}
+	for _, n := range inputs {
+		if in.Err() == nil && n < 1 {
+			in.Errorf("%d is not positive", n)
+		}
+	}
+	if in.Err() != nil {
+		return nil, in.Err()
+	}
+	return inputs, nil
+}
+
+// SolveMakingCandies reads HackerRank's input and writes the minimum number of passes.
+func SolveMakingCandies(r io.Reader, w io.Writer) error {
+	inputs, err := ReadMakingCandies(r)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, MinimumPasses(inputs[0], inputs[1], inputs[2], inputs[3]))
+	return err
+}
@@ -0,0 +1,3 @@
+// Package search solves the "Search" problems
+// of the HackerRank Interview Preparation Kit.
+package search
@@ -0,0 +1,257 @@
+package trees
+
+// https://www.hackerrank.com/challenges/balanced-forest/problem
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/abucarlo/hackerrank/interviews/graphs"
+	"github.com/abucarlo/hackerrank/interviews/input"
+)
+
+// Problem is a single query: the values of the nodes 1 through n,
+// and the n - 1 edges of the tree.
+type Problem struct {
+	Values []int32
+	Edges  [][]int32
+}
+
+// Node is a node of the rooted tree, carrying the total value of its subtree.
+type Node struct {
+	Id       int32
+	Value    int32
+	Subtotal int64
+	Parent   *Node
+	Children []*Node
+}
+
+func (n *Node) String() string {
+	s := fmt.Sprintf("{Id: %d, Value: %d, Subtotal: %d, Children: ", n.Id, n.Value, n.Subtotal)
+	children := []int32{}
+	for _, child := range n.Children {
+		children = append(children, child.Id)
+	}
+	s += fmt.Sprintf("%v}", children)
+	return s
+}
+
+func wire(node *Node) {
+	node.Subtotal = int64(node.Value)
+	for i := 0; i < len(node.Children); i++ {
+		child := node.Children[i]
+		wire(child)
+		node.Subtotal += child.Subtotal
+	}
+}
+
+// Disjoint determines if one node is the descendant of another.
+func Disjoint(m, n *Node) bool {
+	// Edge case: a node is not disjoint with itself.
+	if m == n {
+		return false
+	}
+	// Since every node has a value of at least 1, every node
+	// must have a subtotal greater than any of its descendants.
+	// Therefore two nodes with the same subtotal must be disjoint.
+	if m.Subtotal == n.Subtotal {
+		return true
+	}
+	// The node with the lower subtotal cannot be the ancestor.
+	if m.Subtotal < n.Subtotal {
+		m, n = n, m
+	}
+	// Now follow the path to the root.
+	for ; n != nil && n.Subtotal <= m.Subtotal; n = n.Parent {
+		if n.Parent == m {
+			return false
+		}
+	}
+	return true
+}
+
+func mkMap(nodes []*Node) map[int64][]*Node {	
+	m := make(map[int64][]*Node)
+	for _, n := range nodes {
+		m[n.Subtotal] = append(m[n.Subtotal], n)
+	}
+	return m
+}
+
+func mkNode(node *Node, nodes []*Node, adjacency [][]int32) {
+	node.Children = make([]*Node, 0, len(adjacency[node.Id]))
+	for _, id := range adjacency[node.Id] {
+		if node.Parent != nil && id == node.Parent.Id {
+			continue
+		}
+		child := nodes[id]
+		child.Parent = node
+		node.Children = append(node.Children, child)
+
+		mkNode(child, nodes, adjacency)
+	}
+}
+
+func mkTree(c []int32, edges [][]int32) ([]*Node, *Node) {
+	// The first value is 0: there is no node 0.
+	adjacency := make([][]int32, len(c) + 1)
+
+	for _, edge := range edges {
+		u, v := edge[0], edge[1]
+		adjacency[u] = append(adjacency[u], v)
+		adjacency[v] = append(adjacency[v], u)
+	}
+
+	nodes := make([]*Node, len(c) + 1)
+	for i, cost := range c {
+		nodes[i + 1] = &Node{int32(i + 1), cost, 0, nil, nil}
+	}
+
+	r := rand.Intn(len(nodes) - 1) + 1
+	// TODO: Is 1 always the root?
+	root := nodes[r]
+	mkNode(root, nodes, adjacency)
+
+	return nodes[1:], root
+}
+
+// BalancedForest returns the minimum value of a new node which, once
+// attached to the tree, allows the tree to be cut into three trees of equal
+// total value. It returns -1 if that is not possible.
+func BalancedForest(c []int32, edges [][]int32) int64 {
+	nodes, root := mkTree(c, edges)
+	wire(root)
+	// TODO: Keep only counts.
+	sort.Slice(nodes, func (i, j int) bool { return nodes[i].Subtotal <= nodes[j].Subtotal })
+	// TODO: Get rid of children.
+	countsBySubtotal := mkMap(nodes)
+
+	// First option: two disjoint subtrees have the same total value. Detach them
+	// and add a balancing node to the remaining tree. Since every node has a value
+	// of at least one, two with the same total value must be disjoint (i.e. one
+	// cannot be the ancestor of another without having a higher total value).
+	lowerBound := (root.Subtotal + 2) / 3
+	// It's not clear from the problem statement, but yes, we are allowed to synthesize
+	// an entirely new node to balance the tree. So the highest value to try is half
+	// the total value of the tree.
+	upperBound := root.Subtotal / 2
+
+	current := int64(math.MaxInt64)
+
+	for _, subtree := range nodes {
+		// This is the minimum possible result.
+		if current == 3 - (root.Subtotal % 3) {
+			break
+		}
+		if subtree.Subtotal > upperBound {
+			break
+		}
+
+		if subtree.Subtotal < lowerBound {
+			if subtree.Subtotal % 2 != root.Subtotal % 2 {
+				continue
+			}
+
+			target := (root.Subtotal - subtree.Subtotal) / 2
+
+			mutated := make(map[int64]*Node)
+			blah := make(map[*Node]struct{})
+			for p := subtree.Parent; p != nil; p = p.Parent {
+				mutated[p.Subtotal - subtree.Subtotal] = p
+				blah[p] = struct{}{}
+			}
+
+			if _, ok := mutated[target]; ok {
+				current = min(current, target - subtree.Subtotal)
+			} else if nodes, ok := countsBySubtotal[target]; ok {
+				for _, n := range nodes {
+					if _, nok := blah[n]; !nok {
+						current = min(current, target - subtree.Subtotal)
+						break
+					}
+				}				
+			}
+
+		} else {
+			target := subtree.Subtotal
+			remainder := root.Subtotal - 2 * target
+
+			if len(countsBySubtotal[target]) > 1 {
+				current = min(current, target - remainder)
+				continue
+			}
+
+			for p := subtree.Parent; p != nil; p = p.Parent {
+				if p.Subtotal == 2 * target || p.Subtotal == target + remainder {
+					current = min(current, target - remainder)
+					break
+				}
+			}
+		}
+	}
+
+	if current == int64(math.MaxInt64) {
+		return -1
+	}
+
+	return current
+}
+
+// ReadBalancedForest reads HackerRank's input: the number of queries, then
+// for each one the number of nodes, their values, and the edges of the tree.
+func ReadBalancedForest(r io.Reader) ([]Problem, error) {
+	in := input.NewScanner(r)
+	q := in.Count(5)
+	problems := []Problem{}
+	for range q {
+		n := int32(in.Count(50000))
+		if in.Err() == nil && n < 1 {
+			in.Errorf("a tree must have at least 1 node")
+		}
+		// Nodes are 1-indexed.
+		c := make([]int32, n)
+		for i := range c {
+			c[i] = in.Int32()
+		}
+		// n - 1 edges without a cycle join all n nodes.
+		joined := graphs.NewUnionFind[int32]()
+		edges := make([][]int32, max(n-1, 0))
+		for i := range edges {
+			u, v := in.Int32(), in.Int32()
+			if in.Err() != nil {
+				break
+			}
+			if u < 1 || u > n || v < 1 || v > n || u == v {
+				in.Errorf("edge (%d, %d) does not join two of the nodes 1 through %d", u, v, n)
+				break
+			}
+			if !joined.Union(u, v) {
+				in.Errorf("edge (%d, %d) closes a cycle", u, v)
+				break
+			}
+			edges[i] = []int32{u, v}
+		}
+		if in.Err() != nil {
+			break
+		}
+		problems = append(problems, Problem{c, edges})
+	}
+	return problems, in.Err()
+}
+
+// SolveBalancedForest reads HackerRank's input and writes one answer per query.
+func SolveBalancedForest(r io.Reader, w io.Writer) error {
+	problems, err := ReadBalancedForest(r)
+	if err != nil {
+		return err
+	}
+	for _, problem := range problems {
+		if _, err := fmt.Fprintln(w, BalancedForest(problem.Values, problem.Edges)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
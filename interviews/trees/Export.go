@@ -0,0 +1,31 @@
+package trees
+
+import (
+	"strconv"
+
+	"github.com/abucarlo/hackerrank/interviews/export"
+)
+
+// Export returns the subtree rooted at n for writing as DOT or GraphML,
+// with edges from parent to child. Every node has its Value and Subtotal
+// as the "value" and "subtotal" attributes.
+func (n *Node) Export(name string) export.Graph {
+	g := export.Graph{Name: name, Directed: true}
+	// Search iteratively, since a tree may be a long path.
+	stack := []*Node{n}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		id := strconv.Itoa(int(node.Id))
+		g.Nodes = append(g.Nodes, export.Node{ID: id, Attributes: []export.Attribute{
+			{Key: "value", Value: node.Value},
+			{Key: "subtotal", Value: node.Subtotal},
+		}})
+		for i := len(node.Children) - 1; i >= 0; i-- {
+			child := node.Children[i]
+			g.Edges = append(g.Edges, export.Edge{From: id, To: strconv.Itoa(int(child.Id))})
+			stack = append(stack, child)
+		}
+	}
+	return g
+}
@@ -0,0 +1,47 @@
+package trees
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/abucarlo/hackerrank/interviews/export"
+)
+
+func TestExport(t *testing.T) {
+	leaf := &Node{Id: 3, Value: 5}
+	child := &Node{Id: 2, Value: 2, Children: []*Node{leaf}}
+	root := &Node{Id: 1, Value: 1, Children: []*Node{child}}
+	child.Parent, leaf.Parent = root, child
+	wire(root)
+
+	var out strings.Builder
+	if err := export.WriteDOT(&out, root.Export("tree")); err != nil {
+		t.Fatal(err)
+	}
+	expected := `digraph "tree" {
+	1 [value=1, subtotal=8];
+	2 [value=2, subtotal=7];
+	3 [value=5, subtotal=5];
+	1 -> 2;
+	2 -> 3;
+}
+`
+	if out.String() != expected {
+		t.Errorf("Expected\n%s\ngot\n%s", expected, out.String())
+	}
+
+	out.Reset()
+	if err := export.WriteGraphML(&out, child.Export("subtree")); err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		`<key id="value" for="node" attr.name="value" attr.type="int"/>`,
+		`<key id="subtotal" for="node" attr.name="subtotal" attr.type="long"/>`,
+		`<data key="subtotal">7</data>`,
+		`<edge source="2" target="3"/>`,
+	} {
+		if !strings.Contains(out.String(), s) {
+			t.Errorf("Expected %s in\n%s", s, out.String())
+		}
+	}
+}
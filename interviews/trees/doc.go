@@ -0,0 +1,3 @@
+// Package trees solves the "Trees" problems
+// of the HackerRank Interview Preparation Kit.
+package trees